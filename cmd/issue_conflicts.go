@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// errUpdateAborted is returned when the user aborts an update after a
+// concurrent edit was detected.
+var errUpdateAborted = errors.New("update aborted")
+
+// saveIssueUpdate sends the update after checking that nobody else changed
+// the issue in the meantime (see resolveConcurrentEdits). If nothing is left
+// to change, the latest issue is returned without a mutation. Successful
+// updates are recorded in the journal.
+func saveIssueUpdate(apiKey string, update *issueUpdate, interactive bool) (*linear.IssueNode, error) {
+	update = resolveConcurrentEdits(apiKey, update, interactive)
+	if update == nil {
+		return nil, errUpdateAborted
+	}
+	if update.empty() {
+		return update.issue, nil
+	}
+	updated, err := linear.UpdateIssue(apiKey, update.issue.ID, update.input)
+	if err != nil {
+		return nil, err
+	}
+	recordUpdate(updated, update.changes)
+	return updated, nil
+}
+
+// remoteChanges returns what changed on the issue between base and latest,
+// expressed as an issueUpdate against base.
+func remoteChanges(base, latest *linear.IssueNode, details *linear.TeamDetails) *issueUpdate {
+	remote := newIssueUpdate(base, details)
+	remote.setTitle(latest.Title)
+	remote.setDescription(latest.Description)
+	remote.setTeam(latest.Team.ID, latest.Team.Name)
+	remote.setStateID(latest.State.ID)
+	if latest.Assignee != nil {
+		remote.setAssignee(latest.Assignee.ID, latest.Assignee.Name)
+	} else {
+		remote.setAssignee("", "")
+	}
+	remote.setProjectID(latest.ProjectID())
+	remote.setPriority(latest.Priority)
+	remote.setLabelIDs(latest.LabelIDs())
+	remote.setEstimate(latest.EstimateValue())
+	remote.setDueDate(latest.DueDate)
+	remote.setCycleID(latest.CycleID())
+	if latest.Parent != nil {
+		remote.setParent(&linear.IssueNode{ID: latest.Parent.ID, Identifier: latest.Parent.Identifier})
+	} else {
+		remote.setParent(nil)
+	}
+	// Resolve names that the base issue's team details may not know.
+	for i, change := range remote.changes {
+		if change.Field == "state" {
+			remote.changes[i].To = latest.State.Name
+		}
+		if change.Field == "labels" {
+			remote.changes[i].To = joinOrNone(latest.LabelNames())
+		}
+	}
+	return remote
+}
+
+// rebaseUpdate re-applies the local changes on top of latest. Label changes
+// are replayed as additions and removals so labels added remotely survive.
+func rebaseUpdate(local *issueUpdate, latest *linear.IssueNode) *issueUpdate {
+	rebased := newIssueUpdate(latest, local.details)
+	for _, change := range local.changes {
+		value := local.input[change.Key]
+		switch change.Field {
+		case "title":
+			rebased.setTitle(value.(string))
+		case "description":
+			rebased.setDescription(value.(string))
+		case "team":
+			rebased.setTeam(value.(string), change.To)
+		case "project":
+			rebased.setProjectID(stringValue(value))
+		case "assignee":
+			rebased.setAssignee(stringValue(value), change.To)
+		case "state":
+			rebased.setStateID(value.(string))
+		case "priority":
+			rebased.setPriority(value.(int))
+		case "labels":
+			base := local.issue.LabelIDs()
+			ours := value.([]string)
+			merged := slices.Clone(latest.LabelIDs())
+			for _, id := range ours {
+				if !slices.Contains(base, id) && !slices.Contains(merged, id) {
+					merged = append(merged, id)
+				}
+			}
+			merged = slices.DeleteFunc(merged, func(id string) bool {
+				return slices.Contains(base, id) && !slices.Contains(ours, id)
+			})
+			rebased.setLabelIDs(merged)
+		case "estimate":
+			rebased.setEstimate(value.(*int))
+		case "due":
+			rebased.setDueDate(stringValue(value))
+		case "cycle":
+			rebased.setCycleID(stringValue(value))
+		case "parent":
+			if id := stringValue(value); id != "" {
+				rebased.setParent(&linear.IssueNode{ID: id, Identifier: change.To})
+			} else {
+				rebased.setParent(nil)
+			}
+		}
+	}
+	return rebased
+}
+
+// resolveConcurrentEdits re-fetches the issue right before it is updated.
+// If someone else changed it since it was fetched, a three-way view of the
+// affected fields is shown and the user chooses to rebase their changes onto
+// the latest version, overwrite, or abort. Without a terminal, changes are
+// rebased automatically unless both sides touched the same field. It returns
+// the update to send, or nil to abort.
+func resolveConcurrentEdits(apiKey string, local *issueUpdate, interactive bool) *issueUpdate {
+	latest, err := linear.ResolveIssue(apiKey, local.issue.ID, "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not check for concurrent edits: %v\n", err)
+		return local
+	}
+	if latest.UpdatedAt.Equal(local.issue.UpdatedAt) {
+		return local
+	}
+
+	remote := remoteChanges(local.issue, latest, local.details)
+	if remote.empty() {
+		// Only fields we do not track changed (e.g. comments or sort order).
+		return rebaseUpdate(local, latest)
+	}
+
+	conflicts := printThreeWayDiff(local, remote, latest)
+
+	if !interactive {
+		if len(conflicts) > 0 {
+			fmt.Fprintf(os.Stderr, "Error: %s was changed concurrently; conflicting fields: %v\n",
+				local.issue.Identifier, conflicts)
+			return nil
+		}
+		fmt.Fprintln(os.Stderr, "Rebasing your changes onto the latest version.")
+		return rebaseUpdate(local, latest)
+	}
+
+	items := []string{
+		"Rebase: apply my changes on top of theirs",
+		"Overwrite: apply my changes as they are",
+		"Abort",
+	}
+	index, err := promptForSelect("The issue changed since you fetched it", items, 0)
+	if err != nil {
+		exitOnPromptError("Selection", err)
+	}
+	switch index {
+	case 0:
+		return rebaseUpdate(local, latest)
+	case 1:
+		return local
+	default:
+		return nil
+	}
+}
+
+// printThreeWayDiff shows base, their and our value for every field changed
+// on either side and returns the fields changed on both.
+func printThreeWayDiff(local, remote *issueUpdate, latest *linear.IssueNode) []string {
+	fmt.Fprintf(os.Stderr, "%s was changed by someone else at %s.\n",
+		local.issue.Identifier, latest.UpdatedAt.Local().Format("2006-01-02 15:04"))
+
+	var fields []string
+	for _, change := range append(slices.Clone(remote.changes), local.changes...) {
+		if !slices.Contains(fields, change.Field) {
+			fields = append(fields, change.Field)
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  FIELD\tBASE\tTHEIRS\tYOURS\t")
+	var conflicts []string
+	for _, field := range fields {
+		base, theirs, yours := "", "(unchanged)", "(unchanged)"
+		theirChange, theyChanged := findChange(remote, field)
+		ourChange, weChanged := findChange(local, field)
+		if theyChanged {
+			base, theirs = theirChange.From, theirChange.To
+		}
+		if weChanged {
+			base, yours = ourChange.From, ourChange.To
+		}
+		marker := ""
+		if theyChanged && weChanged && theirChange.To != ourChange.To {
+			marker = "conflict"
+			conflicts = append(conflicts, field)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", field, base, theirs, yours, marker)
+	}
+	w.Flush()
+	return conflicts
+}
+
+func findChange(update *issueUpdate, field string) (fieldChange, bool) {
+	for _, change := range update.changes {
+		if change.Field == field {
+			return change, true
+		}
+	}
+	return fieldChange{}, false
+}
+
+// stringValue unwraps the string-or-nil values used for optional IDs.
+func stringValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// printIssue prints the fields of an issue, one per line, skipping the
+// optional ones that are not set.
+func printIssue(issue linear.IssueNode) {
+	fmt.Printf("  Issue: %s (%s)\n", issue.Identifier, issue.ID)
+	fmt.Printf("  Title: %s\n", issue.Title)
+	// You might want to truncate long descriptions
+	fmt.Printf("  Description: %s\n", issue.Description)
+	fmt.Printf("  Team: %s (%s)\n", issue.Team.Name, issue.Team.ID)
+	fmt.Printf("  State: %s (Type: %s)\n", issue.State.Name, issue.State.Type)
+	if issue.Assignee != nil {
+		fmt.Printf("  Assignee: %s (%s)\n", issue.Assignee.Name, issue.Assignee.ID)
+	} else {
+		fmt.Println("  Assignee: Unassigned")
+	}
+	fmt.Printf("  Priority: %s\n", linear.PriorityName(issue.Priority))
+	if issue.Estimate != nil {
+		fmt.Printf("  Estimate: %s\n", strconv.FormatFloat(*issue.Estimate, 'f', -1, 64))
+	}
+	if len(issue.Labels.Nodes) > 0 {
+		fmt.Printf("  Labels: %s\n", strings.Join(issue.LabelNames(), ", "))
+	}
+	if issue.Project != nil {
+		fmt.Printf("  Project: %s\n", issue.Project.Name)
+	}
+	if issue.Cycle != nil {
+		fmt.Printf("  Cycle: %s\n", issue.Cycle.Label())
+	}
+	if issue.DueDate != "" {
+		fmt.Printf("  Due: %s\n", issue.DueDate)
+	}
+	if issue.Parent != nil {
+		fmt.Printf("  Parent: %s %s\n", issue.Parent.Identifier, issue.Parent.Title)
+	}
+	printRelations(issue)
+	if len(issue.Reactions) > 0 {
+		fmt.Printf("  Reactions: %s\n", formatReactions(issue.Reactions))
+	}
+	if issue.Creator != nil {
+		fmt.Printf("  Creator: %s\n", issue.Creator.Name)
+	}
+	if !issue.CreatedAt.IsZero() {
+		fmt.Printf("  Created: %s\n", issue.CreatedAt.Local().Format("2006-01-02 15:04"))
+		fmt.Printf("  Updated: %s\n", issue.UpdatedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if issue.ArchivedAt != nil {
+		label := "Archived"
+		if issue.Trashed {
+			label = "Trashed"
+		}
+		fmt.Printf("  %s: %s\n", label, issue.ArchivedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if issue.URL != "" {
+		fmt.Printf("  URL: %s\n", issue.URL)
+	}
+}
+
+// relationLabels orders and titles the relation kinds in printIssue.
+var relationLabels = []struct{ kind, label string }{
+	{"blocks", "Blocks"},
+	{"blocked by", "Blocked by"},
+	{"duplicates", "Duplicates"},
+	{"duplicated by", "Duplicated by"},
+	{"related", "Related"},
+	{"similar", "Similar"},
+}
+
+// printRelations prints one line per kind of relation, e.g.
+// "Blocked by: ENG-2 (Todo)".
+func printRelations(issue linear.IssueNode) {
+	relations := issue.IssueRelations()
+	for _, rl := range relationLabels {
+		var refs []string
+		for _, relation := range relations {
+			if relation.Kind == rl.kind {
+				refs = append(refs, fmt.Sprintf("%s (%s)", relation.Issue.Identifier, relation.Issue.State.Name))
+			}
+		}
+		if len(refs) > 0 {
+			fmt.Printf("  %s: %s\n", rl.label, strings.Join(refs, ", "))
+		}
+	}
+}
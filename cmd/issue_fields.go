@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// issueFields holds issue attributes the way a user writes them: team,
+// project, user, state, label and cycle names rather than IDs.
+type issueFields struct {
+	Title       string   `yaml:"title" json:"title"`
+	Description string   `yaml:"-" json:"description"`
+	Team        string   `yaml:"team" json:"team"`
+	Project     string   `yaml:"project" json:"project"`
+	Assignee    string   `yaml:"assignee" json:"assignee"`
+	State       string   `yaml:"state" json:"state"`
+	Labels      []string `yaml:"labels,flow" json:"labels"`
+	Priority    string   `yaml:"priority" json:"priority"`
+	Estimate    string   `yaml:"estimate" json:"estimate"`
+	Due         string   `yaml:"due" json:"due"`
+	Cycle       string   `yaml:"cycle" json:"cycle"`
+	Parent      string   `yaml:"parent" json:"parent"`
+}
+
+// fieldResolver turns names into IDs, caching team lookups so that many
+// issues can be resolved without refetching the same team. It is safe for
+// concurrent use.
+type fieldResolver struct {
+	apiKey  string
+	mu      sync.Mutex
+	teams   []linear.TeamNode
+	details map[string]*linear.TeamDetails
+	issues  map[string]string
+	viewer  *linear.UserNode
+}
+
+func newFieldResolver(apiKey string) *fieldResolver {
+	return &fieldResolver{
+		apiKey:  apiKey,
+		details: map[string]*linear.TeamDetails{},
+		issues:  map[string]string{},
+	}
+}
+
+// team returns the team with the given name, key or ID.
+func (r *fieldResolver) team(name string) (*linear.TeamNode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.teams == nil {
+		teams, err := linear.FetchTeams(r.apiKey)
+		if err != nil {
+			return nil, err
+		}
+		r.teams = teams
+	}
+	for _, team := range r.teams {
+		if team.ID == name || strings.EqualFold(team.Key, name) || strings.EqualFold(team.Name, name) {
+			return &team, nil
+		}
+	}
+	return nil, fmt.Errorf("team %q not found", name)
+}
+
+// teamDetails returns the projects, members, states and labels of a team.
+func (r *fieldResolver) teamDetails(teamID string) (*linear.TeamDetails, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if details, ok := r.details[teamID]; ok {
+		return details, nil
+	}
+	details, err := linear.FetchTeamDetails(r.apiKey, teamID)
+	if err != nil {
+		return nil, err
+	}
+	r.details[teamID] = details
+	return details, nil
+}
+
+// member resolves a user name, display name, email, ID or "@me" within a
+// team. Names may be given with a leading "@" but must match exactly.
+func (r *fieldResolver) member(details *linear.TeamDetails, name string) (*linear.UserNode, error) {
+	if strings.EqualFold(name, "@me") || strings.EqualFold(name, "me") {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.viewer == nil {
+			viewer, err := currentUser(r.apiKey)
+			if err != nil {
+				return nil, err
+			}
+			r.viewer = viewer
+		}
+		return r.viewer, nil
+	}
+	return details.FindMember(strings.TrimPrefix(name, "@"))
+}
+
+// matchMember is member, but also accepts a partial name as long as it
+// matches a single member. When interactive, a partial name matching
+// several members is disambiguated with a prompt instead of failing.
+func (r *fieldResolver) matchMember(details *linear.TeamDetails, name string, interactive bool) (*linear.UserNode, error) {
+	if strings.EqualFold(name, "@me") || strings.EqualFold(name, "me") {
+		return r.member(details, name)
+	}
+
+	name = strings.TrimPrefix(name, "@")
+	matches := details.MatchMembers(name)
+	switch {
+	case len(matches) == 0:
+		return details.FindMember(name)
+	case len(matches) == 1:
+		return &matches[0], nil
+	}
+
+	items := make([]string, len(matches))
+	for i, match := range matches {
+		items[i] = match.Name
+		if match.Email != "" {
+			items[i] += " <" + match.Email + ">"
+		}
+	}
+	if !interactive {
+		return nil, fmt.Errorf("%q matches several members of team %s: %s", name, details.Name, strings.Join(items, ", "))
+	}
+	index, err := promptForSelect(fmt.Sprintf("Which %q", name), items, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &matches[index], nil
+}
+
+// issueID resolves an issue reference to its UUID.
+func (r *fieldResolver) issueID(ref string) (string, error) {
+	r.mu.Lock()
+	id, ok := r.issues[ref]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	issue, err := linear.ResolveIssue(r.apiKey, ref, config.GetDefaultTeam())
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.issues[ref] = issue.ID
+	r.mu.Unlock()
+	return issue.ID, nil
+}
+
+// buildCreateInput resolves fields into an IssueCreateInput. When prompt is
+// true, the title, team, project, assignee, state, labels, priority and
+// estimate are asked for if they are missing; otherwise a missing title or
+// team is an error.
+func buildCreateInput(
+	apiKey string,
+	fields issueFields,
+	prompt bool,
+) (linear.IssueCreateInput, *linear.TeamDetails, error) {
+	return newFieldResolver(apiKey).createInput(fields, prompt)
+}
+
+// createInput is buildCreateInput using the resolver's caches.
+func (r *fieldResolver) createInput(
+	fields issueFields,
+	prompt bool,
+) (linear.IssueCreateInput, *linear.TeamDetails, error) {
+	var input linear.IssueCreateInput
+
+	input.Title = strings.TrimSpace(fields.Title)
+	if input.Title == "" {
+		if !prompt {
+			return input, nil, fmt.Errorf("a title is required")
+		}
+		title, err := promptForRequiredString("Issue Title")
+		if err != nil {
+			exitOnPromptError("Prompt", err)
+		}
+		input.Title = strings.TrimSpace(title)
+	}
+	input.Description = fields.Description
+
+	var teamID string
+	if fields.Team != "" {
+		team, err := r.team(fields.Team)
+		if err != nil {
+			return input, nil, err
+		}
+		teamID = team.ID
+	} else {
+		if !prompt {
+			return input, nil, fmt.Errorf("a team is required")
+		}
+		teamID = selectTeamInteractively(r.apiKey)
+	}
+	input.TeamID = teamID
+
+	details, err := r.teamDetails(teamID)
+	if err != nil {
+		return input, nil, err
+	}
+
+	if fields.Project != "" {
+		project, err := details.FindProject(fields.Project)
+		if err != nil {
+			return input, details, err
+		}
+		input.ProjectID = project.ID
+	} else if prompt {
+		input.ProjectID = promptProjectID(details, "")
+	}
+
+	if fields.Assignee != "" {
+		user, err := r.member(details, fields.Assignee)
+		if err != nil {
+			return input, details, err
+		}
+		input.AssigneeID = user.ID
+	} else if prompt {
+		input.AssigneeID = promptAssigneeID(details, "")
+	}
+
+	if fields.State != "" {
+		state, err := details.FindState(fields.State)
+		if err != nil {
+			return input, details, err
+		}
+		input.StateID = state.ID
+	} else if prompt && len(details.States) > 0 {
+		input.StateID = promptStateID(details, "")
+	}
+
+	for _, name := range fields.Labels {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		label, err := details.FindLabel(name)
+		if err != nil {
+			return input, details, err
+		}
+		input.LabelIDs = append(input.LabelIDs, label.ID)
+	}
+	if len(fields.Labels) == 0 && prompt && len(details.Labels) > 0 {
+		input.LabelIDs = promptLabelIDs(details, nil)
+	}
+
+	if fields.Priority != "" {
+		priority, err := linear.ParsePriority(fields.Priority)
+		if err != nil {
+			return input, details, err
+		}
+		input.Priority = &priority
+	} else if prompt {
+		priority := promptPriority(linear.PriorityNone)
+		input.Priority = &priority
+	}
+
+	if fields.Estimate != "" {
+		estimate, err := details.ParseEstimate(fields.Estimate)
+		if err != nil {
+			return input, details, err
+		}
+		input.Estimate = &estimate
+	} else if prompt {
+		input.Estimate = promptEstimate(details, nil)
+	}
+
+	if fields.Due != "" {
+		if _, err := time.Parse("2006-01-02", fields.Due); err != nil {
+			return input, details, fmt.Errorf("invalid due date %q: expected YYYY-MM-DD", fields.Due)
+		}
+		input.DueDate = fields.Due
+	}
+
+	if fields.Cycle != "" {
+		cycle, err := details.FindCycle(fields.Cycle)
+		if err != nil {
+			return input, details, err
+		}
+		input.CycleID = cycle.ID
+	}
+
+	if fields.Parent != "" {
+		parentID, err := r.issueID(fields.Parent)
+		if err != nil {
+			return input, details, fmt.Errorf("resolving parent issue: %w", err)
+		}
+		input.ParentID = parentID
+	}
+
+	return input, details, nil
+}
+
+// selectTeamInteractively prompts for a team and returns its ID.
+func selectTeamInteractively(apiKey string) string {
+	teams, err := linear.FetchTeams(apiKey)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching teams: %v\n", err)
+		os.Exit(1)
+	}
+	if len(teams) == 0 {
+		fmt.Fprintln(os.Stderr, "No teams found.")
+		os.Exit(1)
+	}
+
+	names := make([]string, len(teams))
+	for i, team := range teams {
+		names[i] = team.Name
+	}
+	index, err := promptForSelect("Select Team", names, 0)
+	if err != nil {
+		exitOnPromptError("Team selection", err)
+	}
+	return teams[index].ID
+}
+
+// optionalTeamID resolves an optional --team flag to a team ID, exiting on
+// failure. An empty name means all teams.
+func optionalTeamID(apiKey, teamName string) string {
+	if teamName == "" {
+		return ""
+	}
+	team, err := linear.FindTeam(apiKey, teamName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	return team.ID
+}
+
+// printCreatedIssue reports a newly created issue as text or JSON.
+func printCreatedIssue(issue *linear.IssueNode, format string) {
+	if format == "json" {
+		printJSON(issue)
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Issue created successfully!")
+	fmt.Printf("%s %s\n", issue.Identifier, issue.URL)
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// The prompt helpers below each show the team's options with the current
+// value preselected and return the chosen value. An empty ID means "none".
+
+func promptProjectID(details *linear.TeamDetails, current string) string {
+	names := []string{"No Project"}
+	selected := 0
+	for i, project := range details.Projects {
+		names = append(names, project.Name)
+		if project.ID == current {
+			selected = i + 1
+		}
+	}
+	index, err := promptForSelect("Select Project", names, selected)
+	if err != nil {
+		exitOnPromptError("Project selection", err)
+	}
+	if index == 0 {
+		return ""
+	}
+	return details.Projects[index-1].ID
+}
+
+func promptAssigneeID(details *linear.TeamDetails, current string) string {
+	names := []string{"Unassigned"}
+	selected := 0
+	for i, member := range details.Members {
+		names = append(names, member.Name)
+		if member.ID == current {
+			selected = i + 1
+		}
+	}
+	index, err := promptForSelect("Select Assignee", names, selected)
+	if err != nil {
+		exitOnPromptError("Assignee selection", err)
+	}
+	if index == 0 {
+		return ""
+	}
+	return details.Members[index-1].ID
+}
+
+func promptStateID(details *linear.TeamDetails, current string) string {
+	names := make([]string, len(details.States))
+	selected := 0
+	for i, state := range details.States {
+		names[i] = state.Name
+		if state.ID == current {
+			selected = i
+		}
+	}
+	index, err := promptForSelect("Select Status", names, selected)
+	if err != nil {
+		exitOnPromptError("Status selection", err)
+	}
+	return details.States[index].ID
+}
+
+func promptPriority(current int) int {
+	index, err := promptForSelect("Select Priority", linear.PriorityNames, current)
+	if err != nil {
+		exitOnPromptError("Priority selection", err)
+	}
+	return index
+}
+
+func promptLabelIDs(details *linear.TeamDetails, current []string) []string {
+	names := make([]string, len(details.Labels))
+	selected := make([]bool, len(details.Labels))
+	for i, label := range details.Labels {
+		names[i] = label.Name
+		if label.Parent != nil {
+			names[i] = label.Parent.Name + "/" + label.Name
+		}
+		for _, id := range current {
+			if id == label.ID {
+				selected[i] = true
+			}
+		}
+	}
+
+	selected, err := promptForMultiSelect("Select Labels", names, selected)
+	if err != nil {
+		exitOnPromptError("Label selection", err)
+	}
+
+	ids := []string{}
+	for i, isSelected := range selected {
+		if isSelected {
+			ids = append(ids, details.Labels[i].ID)
+		}
+	}
+	return ids
+}
+
+// promptEstimate returns nil for "no estimate". Teams that do not use
+// estimates are not prompted and keep current.
+func promptEstimate(details *linear.TeamDetails, current *int) *int {
+	scale := details.EstimateScale()
+	if len(scale) == 0 {
+		return current
+	}
+	names := []string{"No estimate"}
+	selected := 0
+	for i, option := range scale {
+		names = append(names, option.Label)
+		if current != nil && *current == option.Value {
+			selected = i + 1
+		}
+	}
+	index, err := promptForSelect("Select Estimate", names, selected)
+	if err != nil {
+		exitOnPromptError("Estimate selection", err)
+	}
+	if index == 0 {
+		return nil
+	}
+	value := scale[index-1].Value
+	return &value
+}
+
+func promptDueDate(current string) string {
+	return promptDate("Due date", current)
+}
+
+// promptDate asks for a YYYY-MM-DD date; an empty answer means none.
+func promptDate(label, current string) string {
+	prompt := promptui.Prompt{
+		Label:   label + " (YYYY-MM-DD, empty for none)",
+		Default: current,
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return nil
+			}
+			if _, err := time.Parse("2006-01-02", strings.TrimSpace(input)); err != nil {
+				return fmt.Errorf("expected YYYY-MM-DD")
+			}
+			return nil
+		},
+	}
+	value, err := prompt.Run()
+	if err != nil {
+		exitOnPromptError("Prompt", err)
+	}
+	return strings.TrimSpace(value)
+}
+
+func promptCycleID(details *linear.TeamDetails, current string) string {
+	cycles := details.Cycles
+	if details.ActiveCycle != nil {
+		found := false
+		for _, cycle := range cycles {
+			found = found || cycle.ID == details.ActiveCycle.ID
+		}
+		if !found {
+			cycles = append([]linear.CycleNode{*details.ActiveCycle}, cycles...)
+		}
+	}
+
+	names := []string{"No cycle"}
+	selected := 0
+	for i, cycle := range cycles {
+		name := cycle.Label()
+		if details.ActiveCycle != nil && cycle.ID == details.ActiveCycle.ID {
+			name += " (current)"
+		}
+		names = append(names, name)
+		if cycle.ID == current {
+			selected = i + 1
+		}
+	}
+	index, err := promptForSelect("Select Cycle", names, selected)
+	if err != nil {
+		exitOnPromptError("Cycle selection", err)
+	}
+	if index == 0 {
+		return ""
+	}
+	return cycles[index-1].ID
+}
+
+// promptParent asks for a parent issue reference and resolves it. It
+// returns nil for "no parent".
+func promptParent(apiKey, current string) *linear.IssueNode {
+	prompt := promptui.Prompt{
+		Label:   "Parent issue (e.g. ENG-123, empty for none)",
+		Default: current,
+	}
+	for {
+		value, err := prompt.Run()
+		if err != nil {
+			exitOnPromptError("Prompt", err)
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil
+		}
+		parent, err := linear.ResolveIssue(apiKey, value, config.GetDefaultTeam())
+		if err == nil {
+			return parent
+		}
+		fmt.Printf("  %v\n", err)
+		prompt.Default = value
+	}
+}
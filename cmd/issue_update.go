@@ -0,0 +1,307 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// fieldChange describes one changed field: its IssueUpdateInput key, the raw
+// values before and after (as sent to the API) and their display forms.
+type fieldChange struct {
+	Field  string `json:"field"`
+	Key    string `json:"key"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// issueUpdate collects the IssueUpdateInput fields whose new value differs
+// from the issue as it was fetched, so that only real changes are sent.
+type issueUpdate struct {
+	issue   *linear.IssueNode
+	details *linear.TeamDetails
+	input   map[string]any
+	changes []fieldChange
+}
+
+func newIssueUpdate(issue *linear.IssueNode, details *linear.TeamDetails) *issueUpdate {
+	return &issueUpdate{issue: issue, details: details, input: map[string]any{}}
+}
+
+func (u *issueUpdate) record(field, key string, value any, from, to string) {
+	u.input[key] = value
+	for i, change := range u.changes {
+		if change.Field == field {
+			u.changes[i].After = value
+			u.changes[i].To = to
+			return
+		}
+	}
+	u.changes = append(u.changes, fieldChange{
+		Field:  field,
+		Key:    key,
+		Before: u.currentValue(key),
+		After:  value,
+		From:   from,
+		To:     to,
+	})
+}
+
+// currentValue returns the issue's value for an IssueUpdateInput key in the
+// form the API accepts, so that it can be sent back to revert a change.
+func (u *issueUpdate) currentValue(key string) any {
+	issue := u.issue
+	switch key {
+	case "title":
+		return issue.Title
+	case "description":
+		return issue.Description
+	case "teamId":
+		return issue.Team.ID
+	case "projectId":
+		return nilIfEmpty(issue.ProjectID())
+	case "assigneeId":
+		return nilIfEmpty(issue.AssigneeID())
+	case "stateId":
+		return issue.State.ID
+	case "priority":
+		return issue.Priority
+	case "labelIds":
+		return issue.LabelIDs()
+	case "estimate":
+		return issue.EstimateValue()
+	case "dueDate":
+		return nilIfEmpty(issue.DueDate)
+	case "cycleId":
+		return nilIfEmpty(issue.CycleID())
+	case "parentId":
+		return nilIfEmpty(issue.ParentID())
+	}
+	return nil
+}
+
+// empty reports whether nothing changed.
+func (u *issueUpdate) empty() bool {
+	return len(u.input) == 0
+}
+
+// summary renders the changes as "field: from -> to" lines.
+func (u *issueUpdate) summary() []string {
+	lines := make([]string, len(u.changes))
+	for i, change := range u.changes {
+		lines[i] = fmt.Sprintf("%s: %s -> %s", change.Field, change.From, change.To)
+	}
+	return lines
+}
+
+func (u *issueUpdate) setTitle(title string) {
+	if title != u.issue.Title {
+		u.record("title", "title", title, strconv.Quote(u.issue.Title), strconv.Quote(title))
+	}
+}
+
+func (u *issueUpdate) setDescription(description string) {
+	if strings.TrimSpace(description) != strings.TrimSpace(u.issue.Description) {
+		u.record("description", "description", description,
+			describeText(u.issue.Description), describeText(description))
+	}
+}
+
+// setTeam moves the issue to another team. The state, labels and cycle
+// must be set for the new team as well.
+func (u *issueUpdate) setTeam(id, name string) {
+	if id != u.issue.Team.ID {
+		u.record("team", "teamId", id, u.issue.Team.Name, name)
+	}
+}
+
+func (u *issueUpdate) setProjectID(id string) {
+	if id != u.issue.ProjectID() {
+		u.record("project", "projectId", nilIfEmpty(id),
+			u.projectName(u.issue.ProjectID()), u.projectName(id))
+	}
+}
+
+// setAssignee sets the assignee; an empty id unassigns. name may be empty
+// for team members, whose name is looked up.
+func (u *issueUpdate) setAssignee(id, name string) {
+	if id != u.issue.AssigneeID() {
+		from := "unassigned"
+		if u.issue.Assignee != nil {
+			from = u.issue.Assignee.Name
+		}
+		if name == "" {
+			name = u.memberName(id)
+		}
+		u.record("assignee", "assigneeId", nilIfEmpty(id), from, name)
+	}
+}
+
+func (u *issueUpdate) setStateID(id string) {
+	if id != u.issue.State.ID {
+		u.record("state", "stateId", id, u.issue.State.Name, u.stateName(id))
+	}
+}
+
+func (u *issueUpdate) setPriority(priority int) {
+	if priority != u.issue.Priority {
+		u.record("priority", "priority", priority,
+			linear.PriorityName(u.issue.Priority), linear.PriorityName(priority))
+	}
+}
+
+func (u *issueUpdate) setLabelIDs(ids []string) {
+	current := append([]string(nil), u.issue.LabelIDs()...)
+	next := append([]string{}, ids...)
+	sort.Strings(current)
+	sort.Strings(next)
+	if strings.Join(current, ",") != strings.Join(next, ",") {
+		u.record("labels", "labelIds", next, u.labelNames(current), u.labelNames(next))
+	}
+}
+
+func (u *issueUpdate) setEstimate(estimate *int) {
+	current := u.issue.EstimateValue()
+	if (current == nil) != (estimate == nil) || (current != nil && *current != *estimate) {
+		u.record("estimate", "estimate", estimate, u.estimateLabel(current), u.estimateLabel(estimate))
+	}
+}
+
+func (u *issueUpdate) setDueDate(date string) {
+	if date != u.issue.DueDate {
+		u.record("due", "dueDate", nilIfEmpty(date), orNone(u.issue.DueDate), orNone(date))
+	}
+}
+
+func (u *issueUpdate) setCycleID(id string) {
+	if id != u.issue.CycleID() {
+		from := "none"
+		if u.issue.Cycle != nil {
+			from = u.issue.Cycle.Label()
+		}
+		u.record("cycle", "cycleId", nilIfEmpty(id), from, u.cycleName(id))
+	}
+}
+
+// setParent sets the parent issue; nil removes it.
+func (u *issueUpdate) setParent(parent *linear.IssueNode) {
+	id, to := "", "none"
+	if parent != nil {
+		id, to = parent.ID, parent.Identifier
+	}
+	if id != u.issue.ParentID() {
+		from := "none"
+		if u.issue.Parent != nil {
+			from = u.issue.Parent.Identifier
+		}
+		u.record("parent", "parentId", nilIfEmpty(id), from, to)
+	}
+}
+
+func (u *issueUpdate) projectName(id string) string {
+	if id == "" {
+		return "none"
+	}
+	for _, project := range u.details.Projects {
+		if project.ID == id {
+			return project.Name
+		}
+	}
+	if u.issue.Project != nil && u.issue.Project.ID == id {
+		return u.issue.Project.Name
+	}
+	return id
+}
+
+func (u *issueUpdate) memberName(id string) string {
+	if id == "" {
+		return "unassigned"
+	}
+	for _, member := range u.details.Members {
+		if member.ID == id {
+			return member.Name
+		}
+	}
+	return id
+}
+
+func (u *issueUpdate) stateName(id string) string {
+	for _, state := range u.details.States {
+		if state.ID == id {
+			return state.Name
+		}
+	}
+	return id
+}
+
+func (u *issueUpdate) labelNames(ids []string) string {
+	if len(ids) == 0 {
+		return "none"
+	}
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = id
+		for _, label := range u.details.Labels {
+			if label.ID == id {
+				names[i] = label.Name
+			}
+		}
+		for _, label := range u.issue.Labels.Nodes {
+			if label.ID == id {
+				names[i] = label.Name
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func (u *issueUpdate) estimateLabel(estimate *int) string {
+	if estimate == nil {
+		return "none"
+	}
+	return u.details.EstimateLabel(*estimate)
+}
+
+func (u *issueUpdate) cycleName(id string) string {
+	if id == "" {
+		return "none"
+	}
+	if u.details.ActiveCycle != nil && u.details.ActiveCycle.ID == id {
+		return u.details.ActiveCycle.Label()
+	}
+	for _, cycle := range u.details.Cycles {
+		if cycle.ID == id {
+			return cycle.Label()
+		}
+	}
+	return id
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
+
+// describeText summarises a long text for change summaries.
+func describeText(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "empty"
+	}
+	lines := strings.Count(text, "\n") + 1
+	first, _, _ := strings.Cut(text, "\n")
+	if len(first) > 40 {
+		first = first[:40] + "..."
+	}
+	if lines == 1 {
+		return strconv.Quote(first)
+	}
+	return fmt.Sprintf("%q (+%d lines)", first, lines-1)
+}
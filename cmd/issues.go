@@ -18,6 +18,28 @@ func init() {
 	issuesRootCmd.AddCommand(listCmd)
 	issuesRootCmd.AddCommand(createCmd)
 	issuesRootCmd.AddCommand(modifyCmd)
+	issuesRootCmd.AddCommand(historyCmd)
+	issuesRootCmd.AddCommand(importCmd)
+	issuesRootCmd.AddCommand(editCmd)
+	issuesRootCmd.AddCommand(bulkUpdateCmd)
+	for _, t := range transitions {
+		issuesRootCmd.AddCommand(newTransitionCmd(t))
+	}
+	issuesRootCmd.AddCommand(moveCmd)
+	issuesRootCmd.AddCommand(viewCmd)
+	issuesRootCmd.AddCommand(relateCmd)
+	issuesRootCmd.AddCommand(unrelateCmd)
+	issuesRootCmd.AddCommand(relationsCmd)
+	issuesRootCmd.AddCommand(graphCmd)
+	issuesRootCmd.AddCommand(transferCmd)
+	issuesRootCmd.AddCommand(assignCmd)
+	issuesRootCmd.AddCommand(unassignCmd)
+	issuesRootCmd.AddCommand(labelCmd)
+	issuesRootCmd.AddCommand(commentCmd)
+	issuesRootCmd.AddCommand(reactCmd)
+	for _, action := range lifecycleActions {
+		issuesRootCmd.AddCommand(newLifecycleCmd(action))
+	}
 
 	// command flags for filtering and limiting
 	// listCmd.Flags().StringP("team", "t", "", "Filter issues by Team Name")
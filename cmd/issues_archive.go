@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/journal"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// lifecycleAction archives, unarchives, trashes or restores issues.
+type lifecycleAction struct {
+	name  string
+	past  string
+	short string
+	apply func(apiKey, id string) error
+	// applies reports whether the action makes sense for an issue, e.g.
+	// only archived issues can be unarchived.
+	applies func(issue linear.IssueNode) bool
+	// archived means the action works on archived or trashed issues, so
+	// filters must include them, and only them.
+	archived bool
+}
+
+// archivedFilter narrows filter matches to archived and trashed issues.
+var archivedFilter = map[string]any{"archivedAt": map[string]any{"null": false}}
+
+func isArchived(issue linear.IssueNode) bool {
+	return issue.ArchivedAt != nil && !issue.Trashed
+}
+
+var lifecycleActions = []lifecycleAction{
+	{
+		name: journal.ActionArchive, past: "Archived", short: "Archive issues",
+		apply:   linear.ArchiveIssue,
+		applies: func(issue linear.IssueNode) bool { return issue.ArchivedAt == nil },
+	},
+	{
+		name: journal.ActionUnarchive, past: "Unarchived", short: "Unarchive archived issues",
+		apply: linear.UnarchiveIssue, applies: isArchived, archived: true,
+	},
+	{
+		name: journal.ActionDelete, past: "Deleted", short: "Move issues to the trash",
+		apply:   linear.DeleteIssue,
+		applies: func(issue linear.IssueNode) bool { return !issue.Trashed },
+	},
+	{
+		name: journal.ActionRestore, past: "Restored", short: "Restore issues from the trash",
+		apply:    linear.UnarchiveIssue,
+		applies:  func(issue linear.IssueNode) bool { return issue.Trashed },
+		archived: true,
+	},
+}
+
+// newLifecycleCmd builds the command for a lifecycle action.
+func newLifecycleCmd(action lifecycleAction) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   action.name + " [issue...]",
+		Short: action.short,
+		Long: fmt.Sprintf(`%s, given by identifier and/or a --filter expression (see
+'issues bulk-update --help'). The affected issues are listed and you are
+asked to confirm unless --yes is given. Every change is journaled and can be
+reverted with 'linear-cli undo'.`, action.short),
+		Run: func(cmd *cobra.Command, args []string) {
+			runLifecycleAction(cmd, args, action)
+		},
+	}
+	cmd.Flags().String("filter", "", "Filter expression selecting the issues")
+	cmd.Flags().IntP("limit", "l", 0, "Act on at most this many matching issues (0 for all)")
+	cmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	return cmd
+}
+
+func runLifecycleAction(cmd *cobra.Command, refs []string, action lifecycleAction) {
+	filterExpr, _ := cmd.Flags().GetString("filter")
+	limit, _ := cmd.Flags().GetInt("limit")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	apiKey := config.GetAPIKey()
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+		os.Exit(1)
+	}
+
+	q := linear.IssueQuery{Limit: limit}
+	if action.archived {
+		// Archived and trashed issues cannot be told apart by the filter,
+		// so the limit is applied after applies below.
+		q = linear.IssueQuery{Filter: archivedFilter, IncludeArchived: true}
+	}
+	selected, err := selectIssues(apiKey, refs, filterExpr, q)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	var issues []linear.IssueNode
+	var skipped []string
+	for _, issue := range selected {
+		if !action.applies(issue) {
+			skipped = append(skipped, issue.Identifier)
+		} else if limit <= 0 || len(issues) < limit {
+			issues = append(issues, issue)
+		}
+	}
+	switch {
+	case len(skipped) > 10:
+		fmt.Fprintf(os.Stderr, "Skipping %d issues that cannot be %s in their current state.\n",
+			len(skipped), strings.ToLower(action.past))
+	case len(skipped) > 0:
+		fmt.Fprintf(os.Stderr, "Skipping %s: cannot be %s in their current state.\n",
+			strings.Join(skipped, ", "), strings.ToLower(action.past))
+	}
+	if len(issues) == 0 {
+		fmt.Fprintln(os.Stderr, "No issues to "+action.name+".")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tSTATE\tTITLE\t")
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%s\t%s\t%s\t\n", issue.Identifier, issue.State.Name, truncate(issue.Title, 60))
+	}
+	w.Flush()
+
+	if !yes {
+		if !stdinIsTerminal() {
+			fmt.Fprintln(os.Stderr, "Error: confirmation required; re-run with --yes.")
+			os.Exit(1)
+		}
+		if !confirm(fmt.Sprintf("%s %d issue(s)", strings.ToUpper(action.name[:1])+action.name[1:], len(issues))) {
+			fmt.Fprintln(os.Stderr, "Aborted.")
+			return
+		}
+	}
+
+	failed := false
+	for _, issue := range issues {
+		if err := action.apply(apiKey, issue.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", issue.Identifier, err)
+			failed = true
+			continue
+		}
+		appendJournal(journal.Entry{
+			Action:     action.name,
+			IssueID:    issue.ID,
+			Identifier: issue.Identifier,
+		})
+		fmt.Printf("%s %s\n", action.past, issue.Identifier)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
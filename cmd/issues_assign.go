@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// assignCmd represents the issues assign command
+var assignCmd = &cobra.Command{
+	Use:   "assign <issue>... <user>",
+	Short: "Assign issues to a user",
+	Long: `Assigns one or more issues to a member of their team:
+
+  linear-cli issues assign ENG-1 @me
+  linear-cli issues assign ENG-1 ENG-2 alice@corp.com
+  linear-cli issues assign ENG-1 ali
+
+The user is @me, an email, or a full or partial name. A partial name that
+matches several members is disambiguated with a prompt, or is an error
+without a terminal.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		refs, user := args[:len(args)-1], args[len(args)-1]
+		r := newFieldResolver(apiKey)
+		// Remember who a partial name was resolved to in each team, so that
+		// assigning several issues prompts only once.
+		chosen := map[string]*linear.UserNode{}
+		applyToIssues(r, refs, func(issue *linear.IssueNode, update *issueUpdate) error {
+			assignee, ok := chosen[issue.Team.ID]
+			if !ok {
+				var err error
+				if assignee, err = r.matchMember(update.details, user, stdinIsTerminal()); err != nil {
+					return err
+				}
+				chosen[issue.Team.ID] = assignee
+			}
+			update.setAssignee(assignee.ID, assignee.Name)
+			return nil
+		})
+	},
+}
+
+// unassignCmd represents the issues unassign command
+var unassignCmd = &cobra.Command{
+	Use:   "unassign <issue>...",
+	Short: "Remove the assignee of issues",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		applyToIssues(newFieldResolver(apiKey), args, func(issue *linear.IssueNode, update *issueUpdate) error {
+			update.setAssignee("", "")
+			return nil
+		})
+	},
+}
+
+// applyToIssues applies change to each issue and saves it, reporting
+// failures and exiting non-zero if any issue failed. It backs the small
+// single-purpose update commands such as assign and label.
+func applyToIssues(r *fieldResolver, refs []string, change func(*linear.IssueNode, *issueUpdate) error) {
+	failed := false
+	for _, ref := range refs {
+		if err := applyToIssue(r, ref, change); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", ref, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func applyToIssue(r *fieldResolver, ref string, change func(*linear.IssueNode, *issueUpdate) error) error {
+	issue, err := linear.ResolveIssue(r.apiKey, ref, config.GetDefaultTeam())
+	if err != nil {
+		return err
+	}
+	details, err := r.teamDetails(issue.Team.ID)
+	if err != nil {
+		return err
+	}
+	update := newIssueUpdate(issue, details)
+	if err := change(issue, update); err != nil {
+		return err
+	}
+	if update.empty() {
+		fmt.Printf("%s: nothing to change\n", issue.Identifier)
+		return nil
+	}
+	if _, err := saveIssueUpdate(r.apiKey, update, stdinIsTerminal()); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", issue.Identifier, strings.Join(update.summary(), ", "))
+	return nil
+}
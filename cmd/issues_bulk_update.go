@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// bulkSetFields lists the fields --set accepts.
+var bulkSetFields = []string{"title", "description", "project", "assignee", "state", "priority", "labels", "estimate", "due", "cycle", "parent"}
+
+// bulkResult is the planned and, once applied, actual outcome for one issue.
+type bulkResult struct {
+	Identifier string        `json:"identifier"`
+	Title      string        `json:"title"`
+	Changes    []fieldChange `json:"changes"`
+	Error      string        `json:"error,omitempty"`
+	Applied    bool          `json:"applied"`
+
+	update  *issueUpdate
+	updated *linear.IssueNode
+}
+
+// bulkUpdateCmd represents the issues bulk-update command
+var bulkUpdateCmd = &cobra.Command{
+	Use:   "bulk-update [issue...]",
+	Short: "Update every issue matching a filter",
+	Long: `Applies the same changes to many issues, selected by a filter expression
+and/or listed by identifier:
+
+  linear-cli issues bulk-update --filter 'cycle=previous type=started' --set cycle=current
+  linear-cli issues bulk-update --filter 'assignee=alice type!=completed,canceled' \
+      --set assignee=@bob --set state=Backlog --add-label carryover
+
+Filter terms are field=value or field!=value and must all hold; a value may
+list alternatives separated by commas. The fields are team, state, type
+(state type), assignee, creator, project, label, cycle (current, next,
+previous or a number), priority and title (also title~text). != on
+assignee, creator, project or cycle also matches issues without one.
+
+--set takes field=value for title, description, project, assignee, state,
+priority, labels (comma-separated, replaces all), estimate, due, cycle and
+parent; 'none' clears a field.
+
+By default only a dry-run table of the affected issues is printed. With --yes
+the updates run in parallel (-c sets the limit) with a progress bar. At the
+end failures are listed along with a command that retries just those issues.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		filterExpr, _ := cmd.Flags().GetString("filter")
+		sets, _ := cmd.Flags().GetStringArray("set")
+		added, _ := cmd.Flags().GetStringArray("add-label")
+		removed, _ := cmd.Flags().GetStringArray("remove-label")
+		limit, _ := cmd.Flags().GetInt("limit")
+		yes, _ := cmd.Flags().GetBool("yes")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+		if concurrency < 1 {
+			concurrency = 1
+		}
+
+		values, err := parseSetValues(sets)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if len(values) == 0 && len(added) == 0 && len(removed) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: nothing to change; use --set, --add-label or --remove-label.")
+			os.Exit(1)
+		}
+
+		issues, err := selectIssues(apiKey, args, filterExpr, linear.IssueQuery{Limit: limit})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if len(issues) == 0 {
+			fmt.Fprintln(os.Stderr, "No issues match.")
+			return
+		}
+
+		resolver := newFieldResolver(apiKey)
+		results := make([]*bulkResult, len(issues))
+		for i := range issues {
+			results[i] = planBulkUpdate(resolver, &issues[i], values, added, removed)
+		}
+
+		if !yes {
+			if format == "json" {
+				printJSON(results)
+			} else {
+				printBulkPlan(results)
+			}
+			fmt.Fprintln(os.Stderr, "Dry run: nothing was changed. Re-run with --yes to apply.")
+			return
+		}
+
+		applyBulkUpdates(apiKey, results, concurrency)
+
+		if format == "json" {
+			printJSON(results)
+		}
+		if failed := summarizeBulkUpdate(results); len(failed) > 0 {
+			retry := append([]string{"linear-cli", "issues", "bulk-update"}, failed...)
+			for _, set := range sets {
+				retry = append(retry, "--set", shellQuote(set))
+			}
+			for _, label := range added {
+				retry = append(retry, "--add-label", shellQuote(label))
+			}
+			for _, label := range removed {
+				retry = append(retry, "--remove-label", shellQuote(label))
+			}
+			retry = append(retry, "--yes")
+			fmt.Fprintf(os.Stderr, "\nTo retry the failed issues:\n  %s\n", strings.Join(retry, " "))
+			os.Exit(1)
+		}
+	},
+}
+
+// setValue is one --set field=value pair.
+type setValue struct {
+	field string
+	value string
+}
+
+// parseSetValues validates --set arguments, keeping their order.
+func parseSetValues(sets []string) ([]setValue, error) {
+	var values []setValue
+	for _, set := range sets {
+		field, value, ok := strings.Cut(set, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !ok || field == "" {
+			return nil, fmt.Errorf("invalid --set %q: expected field=value", set)
+		}
+		if field == "label" {
+			field = "labels"
+		}
+		if !slices.Contains(bulkSetFields, field) {
+			return nil, fmt.Errorf("cannot set '%s' (expected one of %s)", field, strings.Join(bulkSetFields, ", "))
+		}
+		values = append(values, setValue{field: field, value: strings.TrimSpace(value)})
+	}
+	return values, nil
+}
+
+// selectIssues returns the issues named by refs followed by those matching
+// the filter expression, without duplicates. At least one must be given.
+// q sets the selection set and the limit on filter matches; a q.Filter is
+// combined with the expression.
+func selectIssues(apiKey string, refs []string, filterExpr string, q linear.IssueQuery) ([]linear.IssueNode, error) {
+	if len(refs) == 0 && strings.TrimSpace(filterExpr) == "" {
+		return nil, fmt.Errorf("specify issues or a --filter expression")
+	}
+	fields := q.Fields
+	if fields == "" {
+		fields = linear.IssueFields
+	}
+
+	var issues []linear.IssueNode
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		issue, err := linear.ResolveIssueFields(apiKey, ref, config.GetDefaultTeam(), fields)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[issue.ID] {
+			seen[issue.ID] = true
+			issues = append(issues, *issue)
+		}
+	}
+
+	if strings.TrimSpace(filterExpr) != "" {
+		filter, err := linear.ParseFilter(filterExpr)
+		if err != nil {
+			return nil, err
+		}
+		if q.Filter != nil {
+			filter = map[string]any{"and": []any{filter, q.Filter}}
+		}
+		q.Filter = filter
+		fmt.Fprintln(os.Stderr, "Fetching matching issues...")
+		matches, err := linear.FetchIssues(apiKey, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range matches {
+			if !seen[issue.ID] {
+				seen[issue.ID] = true
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues, nil
+}
+
+// planBulkUpdate works out the changes for one issue. Errors, such as a
+// state that does not exist in the issue's team, are kept in the result.
+func planBulkUpdate(r *fieldResolver, issue *linear.IssueNode, values []setValue, added, removed []string) *bulkResult {
+	result := &bulkResult{Identifier: issue.Identifier, Title: issue.Title}
+
+	details, err := r.teamDetails(issue.Team.ID)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	update := newIssueUpdate(issue, details)
+	for _, v := range values {
+		if v.field == "labels" {
+			names := []string{}
+			if !isNone(v.value) {
+				for _, name := range strings.Split(v.value, ",") {
+					if name = strings.TrimSpace(name); name != "" {
+						names = append(names, name)
+					}
+				}
+			}
+			err = applyLabelChanges(update, names, nil, nil)
+		} else {
+			err = applyFieldValue(r, update, v.field, v.value)
+		}
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+	if len(added) > 0 || len(removed) > 0 {
+		if err := applyLabelChanges(update, nil, added, removed); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+
+	result.update = update
+	result.Changes = update.changes
+	return result
+}
+
+// printBulkPlan prints the dry-run table.
+func printBulkPlan(results []*bulkResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tTITLE\tCHANGES\t")
+	changing := 0
+	for _, result := range results {
+		changes := "(no change)"
+		switch {
+		case result.Error != "":
+			changes = "error: " + result.Error
+		case len(result.Changes) > 0:
+			changes = strings.Join(result.update.summary(), "; ")
+			changing++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t\n", result.Identifier, truncate(result.Title, 40), changes)
+	}
+	w.Flush()
+	fmt.Fprintf(os.Stderr, "\n%d of %d issues would change.\n", changing, len(results))
+}
+
+// applyBulkUpdates saves the planned updates with at most concurrency
+// requests in flight.
+func applyBulkUpdates(apiKey string, results []*bulkResult, concurrency int) {
+	var pending []*bulkResult
+	for _, result := range results {
+		if result.update != nil && !result.update.empty() {
+			pending = append(pending, result)
+		}
+	}
+
+	progress := newProgressBar(len(pending))
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for _, result := range pending {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(result *bulkResult) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			updated, err := saveIssueUpdate(apiKey, result.update, false)
+			if err != nil {
+				result.Error = err.Error()
+				progress.printf("  failed:  %-10s %v\n", result.Identifier, err)
+			} else {
+				result.Applied = true
+				result.updated = updated
+			}
+			progress.add(err == nil)
+		}(result)
+	}
+	wg.Wait()
+	progress.finish()
+}
+
+// summarizeBulkUpdate prints the outcome and returns the identifiers of the
+// issues that failed.
+func summarizeBulkUpdate(results []*bulkResult) []string {
+	var updated, unchanged int
+	var failed []string
+	for _, result := range results {
+		switch {
+		case result.Applied:
+			updated++
+		case result.Error != "":
+			failed = append(failed, result.Identifier)
+		default:
+			unchanged++
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Updated %d, unchanged %d, failed %d.\n", updated, unchanged, len(failed))
+	for _, result := range results {
+		if result.Error != "" {
+			fmt.Fprintf(os.Stderr, "  %s: %s\n", result.Identifier, result.Error)
+		}
+	}
+	return failed
+}
+
+// truncate shortens s to at most n runes, marking the cut with "…".
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9@%+=:,./_-]+$`)
+
+// shellQuote quotes s for a POSIX shell if needed.
+func shellQuote(s string) string {
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func init() {
+	bulkUpdateCmd.Flags().String("filter", "", "Filter expression selecting the issues, e.g. 'team=ENG type=started'")
+	bulkUpdateCmd.Flags().StringArray("set", nil, "Set a field, e.g. --set state=Backlog (repeatable)")
+	bulkUpdateCmd.Flags().StringArray("add-label", nil, "Add a label (repeatable)")
+	bulkUpdateCmd.Flags().StringArray("remove-label", nil, "Remove a label (repeatable)")
+	bulkUpdateCmd.Flags().IntP("limit", "l", 0, "Update at most this many matching issues (0 for all)")
+	bulkUpdateCmd.Flags().BoolP("yes", "y", false, "Apply the changes instead of printing a dry run")
+	bulkUpdateCmd.Flags().IntP("concurrency", "c", 4, "Maximum number of issues updated in parallel")
+	bulkUpdateCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+}
@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/editor"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// commentCmd groups the comment subcommands.
+var commentCmd = &cobra.Command{
+	Use:     "comment",
+	Aliases: []string{"comments"},
+	Short:   "Read and write issue comments",
+}
+
+// commentsRootCmd offers the comment subcommands at the top level as well,
+// as 'linear-cli comments react <comment-id> :eyes:'.
+var commentsRootCmd = &cobra.Command{
+	Use:     "comments",
+	Aliases: []string{"comment"},
+	Short:   "Read and write issue comments (same as 'issues comment')",
+}
+
+// commentListCmd represents the issues comment list command
+var commentListCmd = &cobra.Command{
+	Use:   "list <issue>",
+	Short: "Show the comments of an issue as threads",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		issue, err := linear.ResolveIssue(apiKey, args[0], config.GetDefaultTeam())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+		comments, err := linear.FetchComments(apiKey, issue.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching comments: %v\n", err)
+			os.Exit(1)
+		}
+
+		if format == "json" {
+			if comments == nil {
+				comments = []linear.CommentNode{}
+			}
+			printJSON(comments)
+			return
+		}
+		if len(comments) == 0 {
+			fmt.Printf("%s has no comments.\n", issue.Identifier)
+			return
+		}
+		fmt.Printf("%s: %s\n", issue.Identifier, issue.Title)
+		fmt.Println("--------------------")
+		printCommentThreads(comments, "", time.Now())
+	},
+}
+
+// commentAddCmd represents the issues comment add command
+var commentAddCmd = &cobra.Command{
+	Use:   "add <issue>",
+	Short: "Comment on an issue",
+	Long: `Adds a markdown comment to an issue. The body is taken from -m, read from
+standard input with --stdin, or written in $VISUAL/$EDITOR with --editor,
+which is also the default in a terminal.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		issue, err := linear.ResolveIssue(apiKey, args[0], config.GetDefaultTeam())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+		postComment(cmd, apiKey, issue, "")
+	},
+}
+
+// commentReplyCmd represents the issues comment reply command
+var commentReplyCmd = &cobra.Command{
+	Use:   "reply <comment-id>",
+	Short: "Reply to a comment",
+	Long: `Replies to a comment, taking the body like 'comment add'. Replying to a
+reply adds to the same thread.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		parent, err := linear.FetchComment(apiKey, args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		// Threads are one level deep, so a reply to a reply joins its thread.
+		parentID := parent.ID
+		if parent.ParentID() != "" {
+			parentID = parent.ParentID()
+		}
+		issue := &linear.IssueNode{ID: parent.Issue.ID, Identifier: parent.Issue.Identifier}
+		postComment(cmd, apiKey, issue, parentID)
+	},
+}
+
+// commentEditCmd represents the issues comment edit command
+var commentEditCmd = &cobra.Command{
+	Use:   "edit <comment-id>",
+	Short: "Edit one of your comments",
+	Long: `Replaces the body of a comment with -m or --stdin, or opens the current
+body in $VISUAL/$EDITOR.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		comment, err := linear.FetchComment(apiKey, args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		body, draft, err := readCommentBody(cmd, comment.Body)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if body == strings.TrimSpace(comment.Body) {
+			removeDraft(draft)
+			fmt.Println("No changes.")
+			return
+		}
+
+		updated, err := updateComment(apiKey, comment, body)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error updating comment: %v\n", err)
+			keepDraft(draft)
+			os.Exit(1)
+		}
+		removeDraft(draft)
+		if format == "json" {
+			printJSON(updated)
+			return
+		}
+		fmt.Printf("Comment on %s updated.\n", comment.Issue.Identifier)
+	},
+}
+
+// commentDeleteCmd represents the issues comment delete command
+var commentDeleteCmd = &cobra.Command{
+	Use:   "delete <comment-id>",
+	Short: "Delete a comment",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		comment, err := linear.FetchComment(apiKey, args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if !yes {
+			if !stdinIsTerminal() {
+				fmt.Fprintln(os.Stderr, "Error: confirmation required; re-run with --yes.")
+				os.Exit(1)
+			}
+			printCommentThreads([]linear.CommentNode{*comment}, "", time.Now())
+			if !confirm(fmt.Sprintf("Delete this comment on %s", comment.Issue.Identifier)) {
+				fmt.Fprintln(os.Stderr, "Aborted.")
+				return
+			}
+		}
+
+		if err := deleteComment(apiKey, comment); err != nil {
+			fmt.Fprintf(os.Stderr, "Error deleting comment: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Comment on %s deleted.\n", comment.Issue.Identifier)
+	},
+}
+
+// postComment reads the body and comments on the issue, as a reply when
+// parentID is set.
+func postComment(cmd *cobra.Command, apiKey string, issue *linear.IssueNode, parentID string) {
+	outputFlag, _ := cmd.Flags().GetString("output")
+	format := outputFormat(outputFlag)
+
+	body, draft, err := readCommentBody(cmd, "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	comment, err := createComment(apiKey, issue, parentID, body)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error commenting on %s: %v\n", issue.Identifier, err)
+		keepDraft(draft)
+		os.Exit(1)
+	}
+	removeDraft(draft)
+
+	if format == "json" {
+		printJSON(comment)
+		return
+	}
+	fmt.Printf("Commented on %s %s\n", issue.Identifier, comment.URL)
+}
+
+// readCommentBody returns the comment body given with -m, --stdin or
+// --editor, defaulting to the editor in a terminal. initial pre-fills the
+// editor. When the editor was used, the path of the draft is returned so
+// that it can be kept if posting fails.
+func readCommentBody(cmd *cobra.Command, initial string) (body, draft string, err error) {
+	message, _ := cmd.Flags().GetString("message")
+	useEditor, _ := cmd.Flags().GetBool("editor")
+	useStdin, _ := cmd.Flags().GetBool("stdin")
+	useMessage := cmd.Flags().Changed("message")
+
+	sources := 0
+	for _, set := range []bool{useMessage, useEditor, useStdin} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return "", "", fmt.Errorf("use only one of -m, --editor and --stdin")
+	}
+
+	switch {
+	case useMessage:
+		body = message
+	case useStdin:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", "", fmt.Errorf("reading standard input: %w", err)
+		}
+		body = string(data)
+	case useEditor || stdinIsTerminal():
+		if draft, err = saveDraft("comment-*.md", []byte(initial)); err != nil {
+			return "", "", fmt.Errorf("writing draft: %w", err)
+		}
+		if err := editor.Open(draft); err != nil {
+			return "", "", fmt.Errorf("%w\nDraft kept at %s", err, draft)
+		}
+		data, err := os.ReadFile(draft)
+		if err != nil {
+			return "", "", fmt.Errorf("reading draft: %w", err)
+		}
+		body = string(data)
+	default:
+		return "", "", fmt.Errorf("no comment given; use -m, --stdin or --editor")
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		removeDraft(draft)
+		return "", "", fmt.Errorf("the comment is empty")
+	}
+	return body, draft, nil
+}
+
+func removeDraft(draft string) {
+	if draft != "" {
+		os.Remove(draft)
+	}
+}
+
+func keepDraft(draft string) {
+	if draft != "" {
+		fmt.Fprintf(os.Stderr, "Draft kept at %s\n", draft)
+	}
+}
+
+// printCommentThreads prints comments oldest first with replies indented
+// under the comment they answer. Replies whose parent is not among the
+// comments are printed as top-level comments.
+func printCommentThreads(comments []linear.CommentNode, indent string, now time.Time) {
+	ids := map[string]bool{}
+	replies := map[string][]linear.CommentNode{}
+	for _, comment := range comments {
+		ids[comment.ID] = true
+	}
+	var roots []linear.CommentNode
+	for _, comment := range comments {
+		if parent := comment.ParentID(); parent != "" && ids[parent] {
+			replies[parent] = append(replies[parent], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	var printComment func(comment linear.CommentNode, indent string)
+	printComment = func(comment linear.CommentNode, indent string) {
+		edited := ""
+		if comment.EditedAt != nil {
+			edited = " (edited)"
+		}
+		fmt.Printf("%s%s, %s%s  [%s]\n", indent, comment.Author(), relativeTime(comment.CreatedAt, now), edited, comment.ID)
+		for _, line := range strings.Split(strings.TrimSpace(comment.Body), "\n") {
+			fmt.Printf("%s  %s\n", indent, line)
+		}
+		if len(comment.Reactions) > 0 {
+			fmt.Printf("%s  %s\n", indent, formatReactions(comment.Reactions))
+		}
+		for _, reply := range replies[comment.ID] {
+			printComment(reply, indent+"    ")
+		}
+	}
+	for i, root := range roots {
+		if i > 0 {
+			fmt.Println()
+		}
+		printComment(root, indent)
+	}
+}
+
+func init() {
+	commentCmd.AddCommand(commentListCmd)
+	commentCmd.AddCommand(commentAddCmd)
+	commentCmd.AddCommand(commentReplyCmd)
+	commentCmd.AddCommand(commentEditCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+	commentCmd.AddCommand(commentReactCmd)
+
+	for _, cmd := range []*cobra.Command{commentAddCmd, commentReplyCmd, commentEditCmd} {
+		cmd.Flags().StringP("message", "m", "", "Comment body (markdown)")
+		cmd.Flags().Bool("editor", false, "Write the comment in $VISUAL/$EDITOR")
+		cmd.Flags().Bool("stdin", false, "Read the comment from standard input")
+	}
+	for _, cmd := range []*cobra.Command{commentListCmd, commentAddCmd, commentReplyCmd, commentEditCmd} {
+		cmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+	}
+	commentDeleteCmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	commentReactCmd.Flags().Bool("remove", false, "Remove your reaction instead")
+
+	// A command can only have one parent, so the top-level commands are
+	// copies sharing the flags, which are only ever parsed once.
+	rootCmd.AddCommand(commentsRootCmd)
+	for _, sub := range commentCmd.Commands() {
+		mirror := &cobra.Command{Use: sub.Use, Short: sub.Short, Long: sub.Long, Args: sub.Args, Run: sub.Run}
+		mirror.Flags().AddFlagSet(sub.Flags())
+		commentsRootCmd.AddCommand(mirror)
+	}
+}
@@ -1,15 +1,14 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 
-	"github.com/Matthew-K310/linear-cli/internal/api"
 	"github.com/Matthew-K310/linear-cli/internal/config"
 	"github.com/Matthew-K310/linear-cli/internal/linear"
 )
@@ -17,434 +16,237 @@ import (
 // createCmd represents the create command
 var createCmd = &cobra.Command{
 	Use:   "create",
-	Short: "Create a new Linear issue interactively",
-	Long:  `Interactively prompts for details to create a new Linear issue.`,
+	Short: "Create a new Linear issue",
+	Long: `Creates a new Linear issue. Every field can be given as a flag, using names
+rather than IDs (team name or key, project name, assignee name or email, state
+name, label names). Anything missing is prompted for when stdin is a terminal;
+with --no-input a missing title or team is an error instead.
+
+With --editor (or CREATE_EDITOR=true) the issue is composed in $VISUAL/$EDITOR
+as a markdown file with YAML frontmatter for the fields.
+
+With --stdin the description is read from a pipe, e.g.
+
+  go test ./... 2>&1 | linear-cli issues create --title "Flaky test" --team Core --stdin --code
+
+Before creating, open issues in the team are searched for similar titles and
+descriptions. Pass --force to skip the check, or --check-duplicates-only to
+report candidates without creating anything.`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		format := outputFormat(output)
+		noInput, _ := cmd.Flags().GetBool("no-input")
+		readStdin, _ := cmd.Flags().GetBool("stdin")
+		checkOnly, _ := cmd.Flags().GetBool("check-duplicates-only")
+		// Piped input means stdin is not ours to prompt on.
+		interactive := !noInput && !readStdin && !checkOnly && stdinIsTerminal()
+
+		duplicates := duplicateOptions{}
+		duplicates.skip, _ = cmd.Flags().GetBool("force")
+		duplicates.threshold, _ = cmd.Flags().GetFloat64("duplicate-threshold")
+
 		apiKey := config.GetAPIKey()
 		if apiKey == "" {
 			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
 			os.Exit(1)
 		}
 
-		// Prompt for issue title
-		titlePrompt := promptui.Prompt{
-			Label: "Issue Title",
-			Validate: func(input string) error {
-				if strings.TrimSpace(input) == "" {
-					return fmt.Errorf("title cannot be empty")
-				}
-				return nil
-			},
-		}
-		title, err := titlePrompt.Run()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Prompt failed %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
-			}
-			os.Exit(1)
-		}
-
-		// Prompt for issue description (optional)
-		descriptionPrompt := promptui.Prompt{
-			Label: "Issue Description (Optional)",
-		}
-		description, err := descriptionPrompt.Run()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Prompt failed %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
+		fields := issueFields{}
+		fields.Title, _ = cmd.Flags().GetString("title")
+		fields.Description, _ = cmd.Flags().GetString("description")
+		fields.Team, _ = cmd.Flags().GetString("team")
+		fields.Project, _ = cmd.Flags().GetString("project")
+		fields.Assignee, _ = cmd.Flags().GetString("assignee")
+		fields.State, _ = cmd.Flags().GetString("state")
+		fields.Labels, _ = cmd.Flags().GetStringArray("label")
+		fields.Priority, _ = cmd.Flags().GetString("priority")
+		fields.Estimate, _ = cmd.Flags().GetString("estimate")
+		fields.Due, _ = cmd.Flags().GetString("due")
+		fields.Cycle, _ = cmd.Flags().GetString("cycle")
+		fields.Parent, _ = cmd.Flags().GetString("parent")
+
+		descriptionFile, _ := cmd.Flags().GetString("description-file")
+		sources := 0
+		for _, set := range []bool{cmd.Flags().Changed("description"), descriptionFile != "", readStdin} {
+			if set {
+				sources++
 			}
-			os.Exit(1)
-		}
-		if strings.TrimSpace(description) == "" {
-			description = ""
-		}
-
-		// Query teams to select from
-		teamsQuery := `
-    query Teams {
-      teams {
-        nodes {
-          id
-          name
-        }
-      }
-    }
-    `
-
-		fmt.Println("Fetching teams...")
-		teamsData, err := api.MakeGraphQLRequest(apiKey, teamsQuery, nil)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching teams: %v\n", err)
-			os.Exit(1)
 		}
-
-		var teamsResponse linear.TeamsResponseData
-		if err := json.Unmarshal(teamsData, &teamsResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling teams data: %v\n", err)
-			os.Exit(1)
-		}
-
-		if len(teamsResponse.Teams.Nodes) == 0 {
-			fmt.Fprintln(os.Stderr, "No teams found. Cannot create issue.")
+		if sources > 1 {
+			fmt.Fprintln(os.Stderr, "Error: --description, --description-file and --stdin are mutually exclusive.")
 			os.Exit(1)
 		}
 
-		teamNames := []string{}
-		teamMap := make(map[string]string)
-		for _, team := range teamsResponse.Teams.Nodes {
-			teamNames = append(teamNames, team.Name)
-			teamMap[team.Name] = team.ID
-		}
-
-		// Prompt to select team
-		teamSelectPrompt := promptui.Select{
-			Label: "Select Team",
-			Items: teamNames,
-			Searcher: func(input string, index int) bool {
-				item := teamNames[index]
-				return strings.Contains(strings.ToLower(item), strings.ToLower(input))
-			},
-		}
-
-		_, selectedTeamName, err := teamSelectPrompt.Run()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Team selection failed %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
+		if descriptionFile != "" || readStdin {
+			var content []byte
+			var err error
+			if readStdin {
+				content, err = io.ReadAll(os.Stdin)
+			} else {
+				content, err = os.ReadFile(descriptionFile)
 			}
-			os.Exit(1)
-		}
-
-		selectedTeamID := teamMap[selectedTeamName]
-		fmt.Printf("Selected Team: %s (ID: %s)\n", selectedTeamName, selectedTeamID)
-
-		// projects selector
-		projectsQuery := `
-		query TeamProjects($teamId: String!) {
-			team(id: $teamId) {
-				id
-				name
-				projects {
-					nodes {
-						id
-						name
-					}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading description: %v\n", err)
+				os.Exit(1)
+			}
+			text := strings.TrimRight(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+
+			titleFromFirstLine, _ := cmd.Flags().GetBool("title-from-first-line")
+			if titleFromFirstLine {
+				text = strings.TrimLeft(text, "\n")
+				firstLine, rest, _ := strings.Cut(text, "\n")
+				if strings.TrimSpace(fields.Title) == "" {
+					fields.Title = strings.TrimSpace(firstLine)
 				}
+				text = strings.TrimLeft(rest, "\n")
 			}
-		}
-		`
-
-		projectsVariables := map[string]any{
-			"teamId": selectedTeamID,
-		}
-
-		fmt.Println("Fetching possible projects for the selected team...")
-		projectsData, err := api.MakeGraphQLRequest(apiKey, projectsQuery, projectsVariables)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching projects for team %s: %v\n", selectedTeamID, err)
-			os.Exit(1)
-		}
-
-		var teamProjectsResponse linear.TeamProjectsResponseData
-		if err := json.Unmarshal(projectsData, &teamProjectsResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling projects data: %v\n", err)
-			os.Exit(1)
-		}
 
-		projectNames := []string{"No Project"} // Add an option for no project
-		projectMap := make(map[string]string)
-		projectMap["No Project"] = "" // Map "No Project" to an empty string/nil ID
-
-		if len(teamProjectsResponse.Team.Projects.Nodes) > 0 {
-			for _, project := range teamProjectsResponse.Team.Projects.Nodes {
-				projectNames = append(projectNames, project.Name)
-				projectMap[project.Name] = project.ID
+			if asCode, _ := cmd.Flags().GetBool("code"); asCode && text != "" {
+				lang, _ := cmd.Flags().GetString("lang")
+				text = fenceCode(text, lang)
 			}
-		} else {
-			fmt.Fprintln(os.Stderr, "No projects found for the selected team, only 'No Project' option available.")
-		}
-
-		// prompt to select project
-		projectSelectPrompt := promptui.Select{
-			Label: "Select Project",
-			Items: projectNames,
-			Searcher: func(input string, index int) bool {
-				item := projectNames[index]
-				return strings.Contains(strings.ToLower(item), strings.ToLower(input))
-			},
-		}
-
-		_, selectedProjectNameFromPrompt, err := projectSelectPrompt.Run()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Project selection failed %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
+			fields.Description = text
+		}
+
+		templateName, _ := cmd.Flags().GetString("template")
+		if templateName != "" {
+			vars := map[string]string{}
+			rawVars, _ := cmd.Flags().GetStringArray("var")
+			for _, raw := range rawVars {
+				key, value, ok := strings.Cut(raw, "=")
+				if !ok {
+					fmt.Fprintf(os.Stderr, "Error: --var must be key=value, got '%s'\n", raw)
+					os.Exit(1)
+				}
+				vars[key] = value
 			}
-			os.Exit(1)
-		}
 
-		selectedProjectID := projectMap[selectedProjectNameFromPrompt]
-		fmt.Printf(
-			"Selected Project: %s (ID: %s)\n",
-			selectedProjectNameFromPrompt,
-			selectedProjectID,
-		)
-
-		// Query members (assignees) for the selected team
-		assigneesQuery := `
-query TeamMembers($teamId: String!) {
-  team(id: $teamId) {
-    members {
-      nodes {
-        id
-        name
-      }
-    }
-  }
-}
-`
-
-		assigneesVariables := map[string]any{
-			"teamId": selectedTeamID,
-		}
-
-		assigneesData, err := api.MakeGraphQLRequest(apiKey, assigneesQuery, assigneesVariables)
-		if err != nil {
-			fmt.Fprintf(
-				os.Stderr,
-				"Error fetching assignees for team %s: %v\n",
-				selectedTeamID,
-				err,
-			)
-			os.Exit(1)
+			templateFields, err := fieldsFromTemplate(templateName, vars, interactive)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			fields = mergeFields(templateFields, fields)
 		}
 
-		var teamMembersResponse linear.TeamMembersResponseData
-		if err := json.Unmarshal(assigneesData, &teamMembersResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling assignees data: %v\n", err)
-			os.Exit(1)
+		useEditor := config.CreateWithEditor()
+		if cmd.Flags().Changed("editor") {
+			useEditor, _ = cmd.Flags().GetBool("editor")
 		}
-
-		assigneeNames := []string{"Unassigned"}
-		assigneeMap := make(map[string]string)
-		assigneeMap["Unassigned"] = "" // Map "Unassigned" to an empty string/nil ID
-
-		if len(teamMembersResponse.Team.Members.Nodes) > 0 {
-			for _, member := range teamMembersResponse.Team.Members.Nodes {
-				assigneeNames = append(assigneeNames, member.Name)
-				assigneeMap[member.Name] = member.ID
+		if useEditor && (readStdin || checkOnly) {
+			if cmd.Flags().Changed("editor") {
+				fmt.Fprintln(os.Stderr, "Error: --editor cannot be combined with --stdin or --check-duplicates-only.")
+				os.Exit(1)
 			}
-		} else {
-			fmt.Fprintln(os.Stderr, "No members found for the selected team, only 'Unassigned' option available.")
+			useEditor = false
 		}
-
-		// Prompt to select assignee
-		assigneeSelectPrompt := promptui.Select{
-			Label: "Select Assignee",
-			Items: assigneeNames,
-			Searcher: func(input string, index int) bool {
-				item := assigneeNames[index]
-				return strings.Contains(strings.ToLower(item), strings.ToLower(input))
-			},
+		if useEditor {
+			if !stdinIsTerminal() {
+				fmt.Fprintln(os.Stderr, "Error: --editor requires an interactive terminal.")
+				os.Exit(1)
+			}
+			createWithEditor(apiKey, fields, duplicates, format)
+			return
 		}
 
-		_, selectedAssigneeNameFromPrompt, err := assigneeSelectPrompt.Run()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Assignee selection failed %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
+		if interactive && strings.TrimSpace(fields.Title) == "" {
+			title, err := promptForRequiredString("Issue Title")
+			if err != nil {
+				exitOnPromptError("Prompt", err)
 			}
-			os.Exit(1)
+			fields.Title = title
 		}
 
-		selectedAssigneeID := assigneeMap[selectedAssigneeNameFromPrompt]
-		fmt.Printf(
-			"Selected Assignee: %s (ID: %s)\n",
-			selectedAssigneeNameFromPrompt,
-			selectedAssigneeID,
-		)
-
-		// Query states (statuses) for the selected team
-		statesQuery := `
-    query TeamStates($teamId: String!) {
-      team(id: $teamId) {
-        id
-        name
-        states {
-          nodes {
-            id
-            name
-            type
-          }
-        }
-      }
-    }
-    `
-
-		statesVariables := map[string]any{
-			"teamId": selectedTeamID,
+		if interactive && fields.Description == "" && !cmd.Flags().Changed("description") && descriptionFile == "" {
+			descriptionPrompt := promptui.Prompt{
+				Label: "Issue Description (Optional)",
+			}
+			description, err := descriptionPrompt.Run()
+			if err != nil {
+				exitOnPromptError("Prompt", err)
+			}
+			fields.Description = strings.TrimSpace(description)
 		}
 
-		fmt.Println("Fetching possible statuses for the selected team...")
-		statesData, err := api.MakeGraphQLRequest(apiKey, statesQuery, statesVariables)
+		input, _, err := buildCreateInput(apiKey, fields, interactive)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching states for team %s: %v\n", selectedTeamID, err)
-			os.Exit(1)
-		}
-
-		var teamStatesResponse linear.TeamStatesResponseData
-		if err := json.Unmarshal(statesData, &teamStatesResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling states data: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
-		if len(teamStatesResponse.Team.States.Nodes) == 0 {
-			fmt.Fprintln(os.Stderr, "No statuses found for the selected team. Cannot set status.")
-			os.Exit(1)
-		}
-
-		stateNames := []string{}
-		stateMap := make(map[string]string)
-		for _, state := range teamStatesResponse.Team.States.Nodes {
-			stateNames = append(stateNames, state.Name)
-			stateMap[state.Name] = state.ID
+		if checkOnly {
+			candidates := findDuplicateCandidates(apiKey, input, duplicates)
+			if format == "json" {
+				printJSON(candidates)
+			} else if len(candidates) > 0 {
+				printDuplicateCandidates(candidates)
+			} else {
+				fmt.Fprintln(os.Stderr, "No likely duplicates found.")
+			}
+			if len(candidates) > 0 {
+				os.Exit(2)
+			}
+			return
 		}
 
-		// Prompt to select issue status
-		stateSelectPrompt := promptui.Select{
-			Label: "Select Status",
-			Items: stateNames,
-			Searcher: func(input string, index int) bool {
-				item := stateNames[index]
-				return strings.Contains(strings.ToLower(item), strings.ToLower(input))
-			},
+		if !confirmNotDuplicate(apiKey, input, duplicates, interactive) {
+			return
 		}
 
-		_, selectedStateNameFromPrompt, err := stateSelectPrompt.Run()
+		fmt.Fprintln(os.Stderr, "Creating issue...")
+		issue, err := createIssue(apiKey, input)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Status selection failed %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
-			}
+			fmt.Fprintf(os.Stderr, "Error creating issue: %v\n", err)
 			os.Exit(1)
 		}
 
-		selectedStateID := stateMap[selectedStateNameFromPrompt]
-		fmt.Printf("Selected Status: %s (ID: %s)\n", selectedStateNameFromPrompt, selectedStateID)
-
-		// Prepare assigneeId and projectId for mutation, nil if empty string
-		var assigneeID any = selectedAssigneeID
-		if selectedAssigneeID == "" {
-			assigneeID = nil
-		}
-
-		var projectID any = selectedProjectID
-		if selectedProjectID == "" {
-			projectID = nil
-		}
-
-		// Issue creation mutation
-		mutation := `
-mutation CreateIssue(
-  $title: String!,
-  $description: String,
-  $teamId: String!,
-  $projectId: String,
-  $assigneeId: String,
-  $stateId: String
-) {
-  issueCreate(
-    input: {
-      title: $title,
-      description: $description,
-      teamId: $teamId,
-      projectId: $projectId,
-      assigneeId: $assigneeId,
-      stateId: $stateId
-    }
-  ) {
-    success
-    issue {
-      id 
-      title
-      description
-      team {
-	      name
-      }
-      project {
-	      name
-      }
-      assignee {
-        name
-      }
-      state {
-        name
-      }
-    }
-  }
+		printCreatedIssue(issue, format)
+	},
 }
-    `
-
-		variables := map[string]any{
-			"title":      title,
-			"teamId":     selectedTeamID,
-			"projectId":  projectID,  // Use nullable projectID
-			"assigneeId": assigneeID, // Use nullable assigneeID
-			"stateId":    selectedStateID,
-		}
-		if description != "" {
-			variables["description"] = description
-		}
 
-		fmt.Println("Creating issue...")
-		createIssueData, err := api.MakeGraphQLRequest(apiKey, mutation, variables)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error making GraphQL request to create issue: %v\n", err)
-			os.Exit(1)
-		}
-
-		var createResponse linear.IssueCreateResponseData
-		if err := json.Unmarshal(createIssueData, &createResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling create issue response data: %v\\n", err)
-			os.Exit(1)
-		}
+func init() {
+	createCmd.Flags().String("title", "", "Issue title")
+	createCmd.Flags().String("description", "", "Issue description (markdown)")
+	createCmd.Flags().String("description-file", "", "Read the description from a file")
+	createCmd.Flags().StringP("team", "t", "", "Team name or key")
+	createCmd.Flags().StringP("project", "p", "", "Project name")
+	createCmd.Flags().String("assignee", "", "Assignee name or email")
+	createCmd.Flags().String("state", "", "Workflow state name (e.g. 'Todo')")
+	createCmd.Flags().StringArray("label", nil, "Label name (repeatable)")
+	createCmd.Flags().String("priority", "", "Priority: urgent, high, medium, low or none")
+	createCmd.Flags().String("estimate", "", "Estimate in the team's estimation scale")
+	createCmd.Flags().String("due", "", "Due date (YYYY-MM-DD)")
+	createCmd.Flags().String("cycle", "", "Cycle number, name or 'current'")
+	createCmd.Flags().String("parent", "", "Parent issue (e.g. ENG-123)")
+	createCmd.Flags().Bool("stdin", false, "Read the description from stdin (never prompts)")
+	createCmd.Flags().Bool("code", false, "Wrap the piped or file description in a code block")
+	createCmd.Flags().String("lang", "", "Language of the --code block (e.g. go, text)")
+	createCmd.Flags().Bool("title-from-first-line", false, "Use the first line of the piped or file description as the title")
+	createCmd.Flags().String("template", "", "Start from a local issue template (see 'linear-cli templates list')")
+	createCmd.Flags().StringArray("var", nil, "Template prompt value as key=value (repeatable)")
+	createCmd.Flags().Bool("editor", false, "Compose the issue in $VISUAL/$EDITOR (default from CREATE_EDITOR)")
+	createCmd.Flags().Bool("force", false, "Skip the duplicate check")
+	createCmd.Flags().Bool("check-duplicates-only", false, "Only report likely duplicates (exit status 2 if any); do not create")
+	createCmd.Flags().Float64("duplicate-threshold", linear.DefaultDuplicateThreshold, "Minimum similarity (0-1) to report an issue as a duplicate")
+	createCmd.Flags().Bool("no-input", false, "Never prompt; fail if a required field is missing")
+	createCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+}
 
-		if createResponse.IssueCreate.Success {
-			fmt.Println("Issue created successfully!")
-			// Check if the issue object exists and print details
-			if createResponse.IssueCreate.Issue.ID != "" { // Use ID as a check
-				fmt.Printf("  ID: %s\n", createResponse.IssueCreate.Issue.ID)
-				fmt.Printf("  Title: %s\n", createResponse.IssueCreate.Issue.Title)
-				if createResponse.IssueCreate.Issue.Description != "" {
-					fmt.Printf("  Description: %s\n", createResponse.IssueCreate.Issue.Description)
-				}
-				// These fields are structs in the response, not just names
-				// Access them as createResponse.IssueCreate.Issue.Team.Name etc.
-				if createResponse.IssueCreate.Issue.Team != "" { // This check is likely wrong based on struct
-					// Corrected check:
-					// if createResponse.IssueCreate.Issue.Team != nil && createResponse.IssueCreate.Issue.Team.Name != "" {
-					// fmt.Printf("  Team: %s\n", createResponse.IssueCreate.Issue.Team) // This will print the struct address
-					// Corrected print:
-					// fmt.Printf("  Team: %s\n", createResponse.IssueCreate.Issue.Team.Name)
-				}
-				// ... apply similar corrections for Project, Assignee, State prints
-				// For brevity, skipping full correction here but note the struct access needed
-			} else {
-				fmt.Fprintln(os.Stderr, "Issue created successfully, but no issue details returned by API.")
+// fenceCode wraps text in a markdown code block whose fence is longer than
+// any run of backticks inside the text.
+func fenceCode(text, lang string) string {
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
 			}
 		} else {
-			fmt.Fprintln(os.Stderr, "Error creating issue: API reported success: false")
-			os.Exit(1)
+			run = 0
 		}
-	},
-}
-
-// init function is not strictly necessary if you register commands in issues.go's init
-// But you can define flags here if needed for the create command
-/*
-func init() {
-	// No specific flags defined for the create command in the original code
+	}
+	fence := strings.Repeat("`", max(3, longest+1))
+	return fence + lang + "\n" + text + "\n" + fence
 }
-*/
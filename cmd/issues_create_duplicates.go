@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// maxDuplicateCandidates is how many possible duplicates are shown.
+const maxDuplicateCandidates = 5
+
+// duplicateOptions controls the duplicate check run before issueCreate.
+type duplicateOptions struct {
+	skip      bool
+	threshold float64
+}
+
+// findDuplicateCandidates searches the target team for issues similar to
+// input, returning at most maxDuplicateCandidates.
+func findDuplicateCandidates(apiKey string, input linear.IssueCreateInput, opts duplicateOptions) []linear.DuplicateCandidate {
+	candidates, err := linear.FindDuplicates(apiKey, input.TeamID, input.Title, input.Description, opts.threshold)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: duplicate check failed: %v\n", err)
+		return nil
+	}
+	if len(candidates) > maxDuplicateCandidates {
+		candidates = candidates[:maxDuplicateCandidates]
+	}
+	return candidates
+}
+
+func printDuplicateCandidates(candidates []linear.DuplicateCandidate) {
+	fmt.Fprintln(os.Stderr, "Possible duplicates:")
+	for _, candidate := range candidates {
+		fmt.Fprintf(os.Stderr, "  %3.0f%%  %s: %s [%s]\n",
+			candidate.Score*100,
+			candidate.Issue.Identifier,
+			candidate.Issue.Title,
+			candidate.Issue.State.Name,
+		)
+	}
+}
+
+// confirmNotDuplicate runs the duplicate check and reports whether creation
+// should go ahead. Interactively the user may abort, continue, or comment on
+// one of the candidates instead, in which case the process exits.
+func confirmNotDuplicate(apiKey string, input linear.IssueCreateInput, opts duplicateOptions, interactive bool) bool {
+	if opts.skip {
+		return true
+	}
+
+	candidates := findDuplicateCandidates(apiKey, input, opts)
+	if len(candidates) == 0 {
+		return true
+	}
+	printDuplicateCandidates(candidates)
+
+	if !interactive {
+		fmt.Fprintln(os.Stderr, "Creating anyway (use --check-duplicates-only to stop on duplicates).")
+		return true
+	}
+
+	items := []string{"Create the issue anyway", "Abort"}
+	for _, candidate := range candidates {
+		items = append(items, fmt.Sprintf("Comment on %s instead", candidate.Issue.Identifier))
+	}
+	index, err := promptForSelect("This issue may already exist", items, 0)
+	if err != nil {
+		exitOnPromptError("Selection", err)
+	}
+
+	switch index {
+	case 0:
+		return true
+	case 1:
+		fmt.Fprintln(os.Stderr, "Aborted.")
+		return false
+	}
+
+	existing := candidates[index-2].Issue
+	body := "**" + input.Title + "**"
+	if input.Description != "" {
+		body += "\n\n" + input.Description
+	}
+	comment, err := createComment(apiKey, &existing, "", body)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error commenting on %s: %v\n", existing.Identifier, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Commented on %s %s\n", existing.Identifier, comment.URL)
+	return false
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/editor"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+var draftComments = []string{
+	"Fill in the fields below and write the description after the closing ---.",
+	"Names are resolved for you: team name or key, assignee name or email, state name.",
+	"Leave the file empty to abort.",
+}
+
+// createWithEditor composes an issue in $EDITOR. fields pre-fill the
+// frontmatter. The draft is kept on disk whenever creation does not succeed.
+func createWithEditor(apiKey string, fields issueFields, duplicates duplicateOptions, format string) {
+	draftPath, err := writeDraft(fields)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing draft: %v\n", err)
+		os.Exit(1)
+	}
+
+	for {
+		if err := editor.Open(draftPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\nDraft kept at %s\n", err, draftPath)
+			os.Exit(1)
+		}
+
+		content, err := os.ReadFile(draftPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading draft: %v\n", err)
+			os.Exit(1)
+		}
+		if strings.TrimSpace(string(content)) == "" {
+			os.Remove(draftPath)
+			fmt.Fprintln(os.Stderr, "Draft is empty, aborting.")
+			os.Exit(0)
+		}
+
+		edited := issueFields{}
+		body, err := editor.ParseFrontmatter(content, &edited)
+		if err == nil {
+			edited.Description = strings.TrimSpace(body)
+			var input linear.IssueCreateInput
+			input, _, err = buildCreateInput(apiKey, edited, false)
+			if err == nil {
+				printIssueSummary(edited)
+				if !confirm("Create this issue") || !confirmNotDuplicate(apiKey, input, duplicates, true) {
+					fmt.Fprintf(os.Stderr, "Draft kept at %s\n", draftPath)
+					os.Exit(0)
+				}
+
+				issue, err := createIssue(apiKey, input)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error creating issue: %v\nDraft kept at %s\n", err, draftPath)
+					os.Exit(1)
+				}
+				os.Remove(draftPath)
+				printCreatedIssue(issue, format)
+				return
+			}
+		}
+
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if !confirm("Edit the draft again") {
+			fmt.Fprintf(os.Stderr, "Draft kept at %s\n", draftPath)
+			os.Exit(1)
+		}
+	}
+}
+
+// writeDraft renders fields into a new markdown draft under the config
+// directory (falling back to the temp dir) and returns its path.
+func writeDraft(fields issueFields) (string, error) {
+	content, err := editor.RenderFrontmatter(fields, fields.Description, draftComments...)
+	if err != nil {
+		return "", err
+	}
+
+	return saveDraft("issue-*.md", content)
+}
+
+// saveDraft writes content to a new file under the drafts directory in the
+// config dir (falling back to the temp dir) and returns its path.
+func saveDraft(pattern string, content []byte) (string, error) {
+	dir := os.TempDir()
+	if configDir, err := config.Dir(); err == nil {
+		draftsDir := filepath.Join(configDir, "drafts")
+		if err := os.MkdirAll(draftsDir, 0o700); err == nil {
+			dir = draftsDir
+		}
+	}
+
+	file, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(content); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// printIssueSummary prints the fields of an issue about to be created.
+func printIssueSummary(fields issueFields) {
+	fmt.Println("--------------------")
+	fmt.Printf("  Title: %s\n", fields.Title)
+	fmt.Printf("  Team: %s\n", fields.Team)
+	printIfSet("Project", fields.Project)
+	printIfSet("Assignee", fields.Assignee)
+	printIfSet("State", fields.State)
+	printIfSet("Labels", strings.Join(fields.Labels, ", "))
+	printIfSet("Priority", fields.Priority)
+	printIfSet("Estimate", fields.Estimate)
+	printIfSet("Due", fields.Due)
+	printIfSet("Cycle", fields.Cycle)
+	printIfSet("Parent", fields.Parent)
+	if fields.Description != "" {
+		lines := strings.Count(fields.Description, "\n") + 1
+		fmt.Printf("  Description: %d line(s)\n", lines)
+	}
+	fmt.Println("--------------------")
+}
+
+func printIfSet(label, value string) {
+	if value != "" {
+		fmt.Printf("  %s: %s\n", label, value)
+	}
+}
+
+// confirm asks a yes/no question and reports whether the answer was yes.
+func confirm(label string) bool {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+	_, err := prompt.Run()
+	return err == nil
+}
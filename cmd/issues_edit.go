@@ -0,0 +1,301 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/editor"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// editErrorPrefix marks the annotations added to a draft that failed
+// validation. They are stripped before the draft is parsed again.
+const editErrorPrefix = "# ERROR: "
+
+// editFrontmatter is the frontmatter of an issue opened with `issues edit`.
+type editFrontmatter struct {
+	Title    string   `yaml:"title"`
+	State    string   `yaml:"state"`
+	Assignee string   `yaml:"assignee"`
+	Project  string   `yaml:"project"`
+	Labels   []string `yaml:"labels,flow"`
+	Priority string   `yaml:"priority"`
+	Estimate string   `yaml:"estimate"`
+	Due      string   `yaml:"due"`
+}
+
+// editCmd represents the issues edit command
+var editCmd = &cobra.Command{
+	Use:   "edit <issue>",
+	Short: "Edit an issue in $EDITOR as markdown with frontmatter",
+	Long: `Opens the issue in $VISUAL/$EDITOR as a markdown file: YAML frontmatter with
+the title, state, assignee, project, labels, priority, estimate and due date,
+followed by the description. After saving, the changed fields are summarised
+and only those are sent to Linear. Invalid values reopen the editor with the
+errors annotated at the top of the file.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+		if !stdinIsTerminal() {
+			fmt.Fprintln(os.Stderr, "Error: issues edit requires an interactive terminal.")
+			os.Exit(1)
+		}
+
+		issue, err := linear.ResolveIssue(apiKey, args[0], config.GetDefaultTeam())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+		details, err := linear.FetchTeamDetails(apiKey, issue.Team.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching team details: %v\n", err)
+			os.Exit(1)
+		}
+
+		draftPath, err := writeEditDraft(issue, details)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing draft: %v\n", err)
+			os.Exit(1)
+		}
+
+		resolver := newFieldResolver(apiKey)
+		for {
+			if err := editor.Open(draftPath); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\nDraft kept at %s\n", err, draftPath)
+				os.Exit(1)
+			}
+
+			content, err := os.ReadFile(draftPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading draft: %v\n", err)
+				os.Exit(1)
+			}
+			content = stripEditErrors(content)
+
+			update, problems := updateFromDraft(resolver, issue, details, content)
+			if len(problems) > 0 {
+				fmt.Fprintln(os.Stderr, "The edited issue has errors:")
+				for _, problem := range problems {
+					fmt.Fprintf(os.Stderr, "  %s\n", problem)
+				}
+				if err := os.WriteFile(draftPath, annotateEditErrors(content, problems), 0o600); err != nil {
+					fmt.Fprintf(os.Stderr, "Error writing draft: %v\n", err)
+					os.Exit(1)
+				}
+				if !confirm("Reopen the editor to fix them") {
+					fmt.Fprintf(os.Stderr, "Draft kept at %s\n", draftPath)
+					os.Exit(1)
+				}
+				continue
+			}
+
+			if update.empty() {
+				os.Remove(draftPath)
+				fmt.Println("No changes.")
+				return
+			}
+
+			fmt.Printf("Changes to %s:\n", issue.Identifier)
+			for _, line := range update.summary() {
+				fmt.Printf("  %s\n", line)
+			}
+			if !yes && !confirm("Apply these changes") {
+				fmt.Fprintf(os.Stderr, "Aborted. Draft kept at %s\n", draftPath)
+				os.Exit(0)
+			}
+
+			updated, err := saveIssueUpdate(apiKey, update, true)
+			if err == errUpdateAborted {
+				fmt.Fprintf(os.Stderr, "Aborted. Draft kept at %s\n", draftPath)
+				os.Exit(1)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error updating issue: %v\nDraft kept at %s\n", err, draftPath)
+				os.Exit(1)
+			}
+			os.Remove(draftPath)
+			fmt.Printf("Issue %s updated successfully!\n", updated.Identifier)
+			return
+		}
+	},
+}
+
+// writeEditDraft serializes the issue into a markdown draft file.
+func writeEditDraft(issue *linear.IssueNode, details *linear.TeamDetails) (string, error) {
+	front := editFrontmatter{
+		Title:    issue.Title,
+		State:    issue.State.Name,
+		Priority: linear.PriorityName(issue.Priority),
+		Labels:   issue.LabelNames(),
+		Due:      issue.DueDate,
+	}
+	if issue.Assignee != nil {
+		front.Assignee = issue.Assignee.Name
+	}
+	if issue.Project != nil {
+		front.Project = issue.Project.Name
+	}
+	if estimate := issue.EstimateValue(); estimate != nil {
+		front.Estimate = details.EstimateLabel(*estimate)
+	}
+
+	content, err := editor.RenderFrontmatter(front, issue.Description,
+		fmt.Sprintf("Editing %s. Only changed fields are saved; empty values clear a field.", issue.Identifier),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return saveDraft(issue.Identifier+"-*.md", content)
+}
+
+// updateFromDraft diffs the edited draft against the issue. Every invalid
+// value is reported rather than just the first.
+func updateFromDraft(
+	r *fieldResolver,
+	issue *linear.IssueNode,
+	details *linear.TeamDetails,
+	content []byte,
+) (*issueUpdate, []string) {
+	update := newIssueUpdate(issue, details)
+
+	var front editFrontmatter
+	body, err := editor.ParseFrontmatter(content, &front)
+	if err != nil {
+		return update, []string{err.Error()}
+	}
+
+	var problems []string
+	report := func(err error) {
+		problems = append(problems, err.Error())
+	}
+
+	if strings.TrimSpace(front.Title) == "" {
+		report(fmt.Errorf("title cannot be empty"))
+	} else {
+		update.setTitle(strings.TrimSpace(front.Title))
+	}
+	update.setDescription(strings.TrimRight(body, "\n"))
+
+	if state, err := details.FindState(front.State); err != nil {
+		report(err)
+	} else {
+		update.setStateID(state.ID)
+	}
+
+	if strings.TrimSpace(front.Assignee) == "" || isNone(front.Assignee) {
+		update.setAssignee("", "")
+	} else if issue.Assignee != nil && front.Assignee == issue.Assignee.Name {
+		// unchanged, even if the assignee has since left the team
+	} else if user, err := r.member(details, front.Assignee); err != nil {
+		report(err)
+	} else {
+		update.setAssignee(user.ID, user.Name)
+	}
+
+	if strings.TrimSpace(front.Project) == "" || isNone(front.Project) {
+		update.setProjectID("")
+	} else if issue.Project != nil && front.Project == issue.Project.Name {
+		// unchanged, even if the project is archived or not among the
+		// team's projects that were fetched
+	} else if project, err := details.FindProject(front.Project); err != nil {
+		report(err)
+	} else {
+		update.setProjectID(project.ID)
+	}
+
+	var labelIDs []string
+	labelsValid := true
+	for _, name := range front.Labels {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		id := ""
+		for _, label := range issue.Labels.Nodes {
+			if strings.EqualFold(label.Name, name) {
+				id = label.ID
+			}
+		}
+		if id == "" {
+			label, err := details.FindLabel(name)
+			if err != nil {
+				report(err)
+				labelsValid = false
+				continue
+			}
+			id = label.ID
+		}
+		labelIDs = append(labelIDs, id)
+	}
+	if labelsValid {
+		update.setLabelIDs(labelIDs)
+	}
+
+	if strings.TrimSpace(front.Priority) == "" {
+		update.setPriority(linear.PriorityNone)
+	} else if priority, err := linear.ParsePriority(front.Priority); err != nil {
+		report(err)
+	} else {
+		update.setPriority(priority)
+	}
+
+	if strings.TrimSpace(front.Estimate) == "" || isNone(front.Estimate) {
+		update.setEstimate(nil)
+	} else if estimate, err := details.ParseEstimate(front.Estimate); err != nil {
+		report(err)
+	} else {
+		update.setEstimate(&estimate)
+	}
+
+	due := strings.TrimSpace(front.Due)
+	if due == "" || isNone(due) {
+		update.setDueDate("")
+	} else if _, err := time.Parse("2006-01-02", due); err != nil {
+		report(fmt.Errorf("invalid due date %q: expected YYYY-MM-DD", due))
+	} else {
+		update.setDueDate(due)
+	}
+
+	return update, problems
+}
+
+// annotateEditErrors inserts the problems as YAML comments right after the
+// opening frontmatter delimiter.
+func annotateEditErrors(content []byte, problems []string) []byte {
+	var annotations strings.Builder
+	for _, problem := range problems {
+		annotations.WriteString(editErrorPrefix + strings.ReplaceAll(problem, "\n", " ") + "\n")
+	}
+	text := string(content)
+	if strings.HasPrefix(text, "---\n") {
+		return []byte("---\n" + annotations.String() + text[len("---\n"):])
+	}
+	return []byte(annotations.String() + text)
+}
+
+// stripEditErrors removes annotations added by annotateEditErrors.
+func stripEditErrors(content []byte) []byte {
+	lines := strings.SplitAfter(string(content), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !strings.HasPrefix(line, editErrorPrefix) {
+			kept = append(kept, line)
+		}
+	}
+	return []byte(strings.Join(kept, ""))
+}
+
+func init() {
+	editCmd.Flags().BoolP("yes", "y", false, "Apply the changes without asking for confirmation")
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/graph"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// graphCmd represents the issues graph command
+var graphCmd = &cobra.Command{
+	Use:   "graph [issue...]",
+	Short: "Export the dependency graph of issues as DOT, Mermaid or JSON",
+	Long: `Builds a graph of the selected issues from their blocking and parent/child
+relations and prints it as Graphviz DOT, a Mermaid flowchart or JSON:
+
+  linear-cli issues graph --project "Q3 Launch" --format mermaid
+  linear-cli issues graph --filter 'team=ENG cycle=current' | dot -Tsvg > plan.svg
+
+Nodes are colored by state type. Related issues outside the selection are
+included with a dashed outline. The critical path, the heaviest chain of
+blocking relations weighted by estimate (1 for unestimated, 0 for closed
+issues), is highlighted in red, and dependency cycles are flagged in orange
+and reported on stderr.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		project, _ := cmd.Flags().GetString("project")
+		filterExpr, _ := cmd.Flags().GetString("filter")
+		format, _ := cmd.Flags().GetString("format")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+		if format != "dot" && format != "mermaid" && format != "json" {
+			fmt.Fprintf(os.Stderr, "Error: unknown format '%s' (expected dot, mermaid or json)\n", format)
+			os.Exit(1)
+		}
+		if project != "" {
+			filterExpr = strings.TrimSpace(filterExpr + " project=" + quoteFilterValue(project))
+		}
+
+		issues, err := selectIssues(apiKey, args, filterExpr, linear.IssueQuery{
+			Fields: linear.IssueFields + linear.IssueRelationFields + linear.IssueChildrenFields,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if len(issues) == 0 {
+			fmt.Fprintln(os.Stderr, "No issues match.")
+			os.Exit(1)
+		}
+
+		g := buildIssueGraph(issues)
+		annotations := graph.Annotations{
+			Cycles: g.Cycles(graph.Blocks),
+		}
+		path, weight := g.CriticalPath(graph.Blocks)
+		annotations.CriticalPath = path
+
+		for _, cycle := range annotations.Cycles {
+			labels := graphLabels(g, cycle)
+			fmt.Fprintf(os.Stderr, "Warning: dependency cycle: %s -> %s\n", strings.Join(labels, " -> "), labels[0])
+		}
+		if len(path) > 0 {
+			fmt.Fprintf(os.Stderr, "Critical path (%g): %s\n", weight, strings.Join(graphLabels(g, path), " -> "))
+		}
+
+		switch format {
+		case "dot":
+			fmt.Print(g.DOT(annotations))
+		case "mermaid":
+			fmt.Print(g.Mermaid(annotations))
+		case "json":
+			cycles := make([][]string, len(annotations.Cycles))
+			for i, cycle := range annotations.Cycles {
+				cycles[i] = graphLabels(g, cycle)
+			}
+			printJSON(map[string]any{
+				"nodes":              g.Nodes,
+				"edges":              g.Edges,
+				"criticalPath":       graphLabels(g, path),
+				"criticalPathWeight": weight,
+				"cycles":             cycles,
+			})
+		}
+	},
+}
+
+// quoteFilterValue quotes a filter value containing spaces.
+func quoteFilterValue(value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// graphWeight is a node's weight for the critical path: its estimate, 1
+// if unestimated, and 0 once closed.
+func graphWeight(stateType string, estimate *float64) float64 {
+	switch {
+	case stateType == "completed" || stateType == "canceled":
+		return 0
+	case estimate != nil && *estimate > 0:
+		return *estimate
+	default:
+		return 1
+	}
+}
+
+// buildIssueGraph adds the issues and everything they block, are blocked
+// by, their parents and their children.
+func buildIssueGraph(issues []linear.IssueNode) *graph.Graph {
+	g := &graph.Graph{}
+	for _, issue := range issues {
+		g.AddNode(graph.Node{
+			ID:        issue.ID,
+			Label:     issue.Identifier,
+			Title:     issue.Title,
+			State:     issue.State.Name,
+			StateType: issue.State.Type,
+			Weight:    graphWeight(issue.State.Type, issue.Estimate),
+		})
+	}
+
+	external := func(id, identifier, title string, state *linear.StateRef) {
+		node := graph.Node{ID: id, Label: identifier, Title: title, External: true, Weight: 1}
+		if state != nil {
+			node.State, node.StateType = state.Name, state.Type
+			node.Weight = graphWeight(state.Type, nil)
+		}
+		g.AddNode(node)
+	}
+
+	for _, issue := range issues {
+		for _, relation := range issue.IssueRelations() {
+			if relation.Type != "blocks" {
+				continue
+			}
+			other := relation.Issue
+			external(other.ID, other.Identifier, other.Title, &linear.StateRef{Name: other.State.Name, Type: other.State.Type})
+			if relation.Inverse {
+				g.AddEdge(graph.Edge{From: other.ID, To: issue.ID, Kind: graph.Blocks})
+			} else {
+				g.AddEdge(graph.Edge{From: issue.ID, To: other.ID, Kind: graph.Blocks})
+			}
+		}
+		if parent := issue.Parent; parent != nil {
+			external(parent.ID, parent.Identifier, parent.Title, parent.State)
+			g.AddEdge(graph.Edge{From: parent.ID, To: issue.ID, Kind: graph.Parent})
+		}
+		if issue.Children != nil {
+			for _, child := range issue.Children.Nodes {
+				external(child.ID, child.Identifier, child.Title, child.State)
+				g.AddEdge(graph.Edge{From: issue.ID, To: child.ID, Kind: graph.Parent})
+			}
+		}
+	}
+	return g
+}
+
+// graphLabels maps node IDs to their labels.
+func graphLabels(g *graph.Graph, ids []string) []string {
+	labels := make([]string, len(ids))
+	for i, id := range ids {
+		node, _ := g.Node(id)
+		labels[i] = node.Label
+	}
+	return labels
+}
+
+func init() {
+	graphCmd.Flags().StringP("project", "p", "", "Graph the issues of this project")
+	graphCmd.Flags().String("filter", "", "Filter expression selecting the issues (see issues bulk-update --help)")
+	graphCmd.Flags().StringP("format", "f", "dot", "Output format: dot, mermaid or json")
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// historyCmd represents the issues history command
+var historyCmd = &cobra.Command{
+	Use:   "history <issue>",
+	Short: "Show the activity timeline of an issue",
+	Long: `Shows a chronological timeline of everything that happened to an issue:
+state transitions (with the time spent in each state), assignee, priority,
+estimate, label, project and cycle changes, and who made each change.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		format := outputFormat(output)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		history, err := linear.FetchIssueHistory(apiKey, args[0], config.GetDefaultTeam())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue history: %v\n", err)
+			os.Exit(1)
+		}
+
+		now := time.Now()
+		spans := history.StateSpans(now)
+
+		if format == "json" {
+			type jsonEntry struct {
+				linear.IssueHistoryNode
+				Changes []string `json:"changes"`
+			}
+			entries := make([]jsonEntry, 0, len(history.Entries))
+			for _, entry := range history.Entries {
+				changes := entry.Changes()
+				if len(changes) == 0 {
+					continue
+				}
+				entries = append(entries, jsonEntry{IssueHistoryNode: entry, Changes: changes})
+			}
+			printJSON(map[string]any{
+				"id":         history.ID,
+				"identifier": history.Identifier,
+				"title":      history.Title,
+				"createdAt":  history.CreatedAt,
+				"entries":    entries,
+				"stateSpans": spans,
+			})
+			return
+		}
+
+		fmt.Printf("%s: %s\n", history.Identifier, history.Title)
+		fmt.Println("--------------------")
+		fmt.Printf("%s  created\n", history.CreatedAt.Local().Format("2006-01-02 15:04"))
+		for _, entry := range history.Entries {
+			changes := entry.Changes()
+			if len(changes) == 0 {
+				continue
+			}
+			fmt.Printf("%s  %s\n", entry.CreatedAt.Local().Format("2006-01-02 15:04"), entry.ActorName())
+			for _, change := range changes {
+				fmt.Printf("    %s\n", change)
+			}
+		}
+
+		fmt.Println("--------------------")
+		fmt.Println("Time in state:")
+		for _, span := range spans {
+			suffix := ""
+			if span.End == nil {
+				suffix = " (current)"
+			}
+			fmt.Printf("  %-20s %s%s\n", span.State.Name, formatDuration(span.Duration), suffix)
+		}
+	},
+}
+
+func init() {
+	historyCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+}
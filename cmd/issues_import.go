@@ -0,0 +1,563 @@
+package cmd
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/editor"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// importRow is a single issue read from an import source.
+type importRow struct {
+	Key    string // stable name of the row, used in the manifest
+	Ref    string // batch-local id other rows can use as their parent
+	Line   int    // line of CSV and JSONL rows, for messages
+	Fields issueFields
+
+	input     linear.IssueCreateInput
+	parentRow *importRow
+	depth     int
+}
+
+// importManifest records which rows of a source were already created so
+// that a re-run only creates what is missing.
+type importManifest struct {
+	Source string                  `json:"source"`
+	Rows   map[string]importResult `json:"rows"`
+}
+
+type importResult struct {
+	ID         string    `json:"id"`
+	Identifier string    `json:"identifier"`
+	URL        string    `json:"url"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// importCmd represents the issues import command
+var importCmd = &cobra.Command{
+	Use:   "import <dir | file.md | file.csv | file.jsonl | ->",
+	Short: "Create many issues from markdown files, CSV or JSONL",
+	Long: `Creates issues in bulk from one of three sources:
+
+  - a directory of markdown files with YAML frontmatter (one issue per file),
+    or a single such file
+  - a CSV file with a header row (map columns with --map Column=field)
+  - JSONL, one JSON object per line, from a file or stdin ("-")
+
+Fields are title, description, team, project, assignee, state, labels,
+priority, estimate, due, cycle and parent, plus an optional "id" that other
+rows can reference as their parent to build parent/child links within the
+batch. Every row is validated before anything is created.
+
+Results are written to a manifest (by default next to the source) mapping
+each row to the created identifier; rows already in the manifest are skipped
+on the next run. CSV and JSONL rows are matched by their id, or else by
+their title, so rows can be added or reordered between runs.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		source := args[0]
+		format, _ := cmd.Flags().GetString("format")
+		mappings, _ := cmd.Flags().GetStringArray("map")
+		defaultTeam, _ := cmd.Flags().GetString("team")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		manifestPath, _ := cmd.Flags().GetString("manifest")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+		if concurrency < 1 {
+			concurrency = 1
+		}
+
+		if format == "" {
+			format = detectImportFormat(source)
+		}
+		rows, err := readImportRows(source, format, mappings)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", source, err)
+			os.Exit(1)
+		}
+		if len(rows) == 0 {
+			fmt.Println("Nothing to import.")
+			return
+		}
+
+		if manifestPath == "" {
+			manifestPath = defaultManifestPath(source, format)
+		}
+		manifest, err := loadImportManifest(manifestPath, source)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading manifest %s: %v\n", manifestPath, err)
+			os.Exit(1)
+		}
+
+		pending, err := validateImportRows(newFieldResolver(apiKey), rows, manifest, defaultTeam)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		skipped := len(rows) - len(pending)
+
+		fmt.Printf("%d row(s) valid, %d to create, %d already imported.\n", len(rows), len(pending), skipped)
+		if dryRun || len(pending) == 0 {
+			for _, row := range pending {
+				fmt.Printf("  would create: %-24s %s\n", row.Key, row.Fields.Title)
+			}
+			return
+		}
+
+		failures := runImport(apiKey, pending, manifest, manifestPath, concurrency)
+
+		fmt.Println("--------------------")
+		fmt.Printf("Created %d, skipped %d, failed %d. Manifest: %s\n",
+			len(pending)-len(failures), skipped, len(failures), manifestPath)
+		if len(failures) > 0 {
+			for _, key := range sortedKeys(failures) {
+				fmt.Fprintf(os.Stderr, "  %s: %v\n", key, failures[key])
+			}
+			fmt.Fprintln(os.Stderr, "Re-run the same command to retry the failed rows.")
+			os.Exit(1)
+		}
+	},
+}
+
+func detectImportFormat(source string) string {
+	if source == "-" {
+		return "jsonl"
+	}
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		return "md"
+	}
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".csv":
+		return "csv"
+	case ".md", ".markdown":
+		return "md"
+	default:
+		return "jsonl"
+	}
+}
+
+func defaultManifestPath(source, format string) string {
+	if source == "-" {
+		return "import-manifest.json"
+	}
+	if info, err := os.Stat(source); err == nil && info.IsDir() && format == "md" {
+		return filepath.Join(source, ".import-manifest.json")
+	}
+	return source + ".manifest.json"
+}
+
+func readImportRows(source, format string, mappings []string) ([]*importRow, error) {
+	switch format {
+	case "md":
+		return readMarkdownRows(source)
+	case "csv":
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return readCSVRows(file, mappings)
+	case "jsonl":
+		if source == "-" {
+			return readJSONLRows(os.Stdin)
+		}
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return readJSONLRows(file)
+	default:
+		return nil, fmt.Errorf("unknown format %q (expected md, csv or jsonl)", format)
+	}
+}
+
+// readMarkdownRows reads the markdown files of a directory, or a single
+// markdown file, as one row each.
+func readMarkdownRows(source string) ([]*importRow, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{source}
+	if info.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(source, "*.md")); err != nil {
+			return nil, err
+		}
+		sort.Strings(paths)
+	}
+
+	var rows []*importRow
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var file struct {
+			ID          string `yaml:"id"`
+			issueFields `yaml:",inline"`
+		}
+		body, err := editor.ParseFrontmatter(content, &file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		file.Description = strings.TrimSpace(body)
+
+		name := filepath.Base(path)
+		ref := file.ID
+		if ref == "" {
+			ref = strings.TrimSuffix(name, ".md")
+		}
+		rows = append(rows, &importRow{Key: name, Ref: ref, Fields: file.issueFields})
+	}
+	return rows, nil
+}
+
+func readCSVRows(r io.Reader, mappings []string) ([]*importRow, error) {
+	columnToField := map[string]string{}
+	for _, mapping := range mappings {
+		column, field, ok := strings.Cut(mapping, "=")
+		if !ok {
+			return nil, fmt.Errorf("--map must be Column=field, got %q", mapping)
+		}
+		columnToField[strings.ToLower(strings.TrimSpace(column))] = strings.TrimSpace(field)
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+	fieldNames := make([]string, len(header))
+	for i, column := range header {
+		fieldNames[i] = strings.ToLower(strings.TrimSpace(column))
+		if field, ok := columnToField[fieldNames[i]]; ok {
+			fieldNames[i] = field
+		}
+	}
+
+	var rows []*importRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		row := &importRow{Line: line}
+		for i, value := range record {
+			if i >= len(fieldNames) || strings.TrimSpace(value) == "" {
+				continue
+			}
+			if err := setImportField(row, fieldNames[i], value); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		rows = append(rows, row)
+	}
+	keyRowsByTitle(rows)
+	return rows, nil
+}
+
+func readJSONLRows(r io.Reader) ([]*importRow, error) {
+	var rows []*importRow
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var object map[string]any
+		if err := json.Unmarshal([]byte(text), &object); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row := &importRow{Line: line}
+		for key, value := range object {
+			var text string
+			switch v := value.(type) {
+			case nil:
+				continue
+			case []any:
+				parts := make([]string, len(v))
+				for i, part := range v {
+					parts[i] = fmt.Sprint(part)
+				}
+				text = strings.Join(parts, ",")
+			case float64:
+				text = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				text = fmt.Sprint(v)
+			}
+			if err := setImportField(row, key, text); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		rows = append(rows, row)
+	}
+	keyRowsByTitle(rows)
+	return rows, scanner.Err()
+}
+
+// keyRowsByTitle keys the rows without an id by a hash of their title
+// rather than their position, so that the manifest still matches them
+// after rows are inserted or removed. Repeated titles are told apart by
+// their occurrence.
+func keyRowsByTitle(rows []*importRow) {
+	seen := map[string]int{}
+	for _, row := range rows {
+		if row.Key != "" {
+			continue
+		}
+		sum := sha256.Sum256([]byte(strings.TrimSpace(row.Fields.Title)))
+		row.Key = fmt.Sprintf("title %x", sum[:6])
+		if seen[row.Key]++; seen[row.Key] > 1 {
+			row.Key += fmt.Sprintf(" #%d", seen[row.Key])
+		}
+	}
+}
+
+// setImportField assigns a CSV column or JSON key to the matching field.
+func setImportField(row *importRow, field, value string) error {
+	f := &row.Fields
+	switch strings.ToLower(field) {
+	case "id", "ref":
+		row.Ref = value
+		row.Key = value
+	case "title":
+		f.Title = value
+	case "description":
+		f.Description = value
+	case "team":
+		f.Team = value
+	case "project":
+		f.Project = value
+	case "assignee":
+		f.Assignee = value
+	case "state", "status":
+		f.State = value
+	case "labels", "label":
+		for _, label := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+			if label = strings.TrimSpace(label); label != "" {
+				f.Labels = append(f.Labels, label)
+			}
+		}
+	case "priority":
+		f.Priority = value
+	case "estimate":
+		f.Estimate = value
+	case "due", "duedate":
+		f.Due = value
+	case "cycle":
+		f.Cycle = value
+	case "parent":
+		f.Parent = value
+	default:
+		return fmt.Errorf("unknown field %q", field)
+	}
+	return nil
+}
+
+func loadImportManifest(path, source string) (*importManifest, error) {
+	manifest := &importManifest{Source: source, Rows: map[string]importResult{}}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Rows == nil {
+		manifest.Rows = map[string]importResult{}
+	}
+	return manifest, nil
+}
+
+func (m *importManifest) save(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// validateImportRows resolves every row that is not in the manifest yet and
+// returns them ordered so that parents come before their children. All
+// problems are reported together.
+func validateImportRows(
+	resolver *fieldResolver,
+	rows []*importRow,
+	manifest *importManifest,
+	defaultTeam string,
+) ([]*importRow, error) {
+	byRef := map[string]*importRow{}
+	for _, row := range rows {
+		if row.Ref == "" {
+			continue
+		}
+		if _, ok := byRef[row.Ref]; ok {
+			return nil, fmt.Errorf("duplicate row id %q", row.Ref)
+		}
+		byRef[row.Ref] = row
+	}
+
+	var pending []*importRow
+	var problems []string
+	for _, row := range rows {
+		if _, done := manifest.Rows[row.Key]; done {
+			continue
+		}
+
+		fields := row.Fields
+		if fields.Team == "" {
+			fields.Team = defaultTeam
+		}
+		if parent, ok := byRef[fields.Parent]; ok && fields.Parent != "" {
+			row.parentRow = parent
+			fields.Parent = ""
+		}
+
+		input, _, err := resolver.createInput(fields, false)
+		if err != nil {
+			where := row.Key
+			if row.Line > 0 {
+				where = fmt.Sprintf("line %d", row.Line)
+			}
+			problems = append(problems, fmt.Sprintf("  %s: %v", where, err))
+			continue
+		}
+		row.input = input
+		pending = append(pending, row)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("validation failed, nothing was created:\n%s", strings.Join(problems, "\n"))
+	}
+
+	for _, row := range pending {
+		seen := map[*importRow]bool{row: true}
+		for parent := row.parentRow; parent != nil; parent = parent.parentRow {
+			if seen[parent] {
+				return nil, fmt.Errorf("parent cycle involving row %s", row.Key)
+			}
+			seen[parent] = true
+			row.depth++
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].depth < pending[j].depth })
+
+	return pending, nil
+}
+
+// runImport creates the pending rows with at most concurrency requests in
+// flight, one depth level at a time so parents exist before their children.
+// It returns the errors of failed rows keyed by row.
+func runImport(
+	apiKey string,
+	pending []*importRow,
+	manifest *importManifest,
+	manifestPath string,
+	concurrency int,
+) map[string]error {
+	var mu sync.Mutex
+	failures := map[string]error{}
+
+	for start := 0; start < len(pending); {
+		end := start
+		for end < len(pending) && pending[end].depth == pending[start].depth {
+			end++
+		}
+
+		var wg sync.WaitGroup
+		slots := make(chan struct{}, concurrency)
+		for _, row := range pending[start:end] {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func(row *importRow) {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				input := row.input
+				if row.parentRow != nil {
+					mu.Lock()
+					parent, ok := manifest.Rows[row.parentRow.Key]
+					mu.Unlock()
+					if !ok {
+						mu.Lock()
+						failures[row.Key] = fmt.Errorf("parent %s was not created", row.parentRow.Key)
+						mu.Unlock()
+						return
+					}
+					input.ParentID = parent.ID
+				}
+
+				issue, err := createIssue(apiKey, input)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failures[row.Key] = err
+					fmt.Fprintf(os.Stderr, "  failed:  %-24s %v\n", row.Key, err)
+					return
+				}
+				manifest.Rows[row.Key] = importResult{
+					ID:         issue.ID,
+					Identifier: issue.Identifier,
+					URL:        issue.URL,
+					CreatedAt:  time.Now(),
+				}
+				if err := manifest.save(manifestPath); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: could not write manifest: %v\n", err)
+				}
+				fmt.Printf("  created: %-24s %s\n", row.Key, issue.Identifier)
+			}(row)
+		}
+		wg.Wait()
+		start = end
+	}
+
+	return failures
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func init() {
+	importCmd.Flags().String("format", "", "Source format: md, csv or jsonl (detected from the source by default)")
+	importCmd.Flags().StringArray("map", nil, "Map a CSV column to a field, e.g. --map Summary=title (repeatable)")
+	importCmd.Flags().StringP("team", "t", "", "Team for rows that do not name one")
+	importCmd.Flags().IntP("concurrency", "c", 4, "Maximum number of issues created in parallel")
+	importCmd.Flags().String("manifest", "", "Path of the results manifest")
+	importCmd.Flags().Bool("dry-run", false, "Validate the rows and show what would be created")
+}
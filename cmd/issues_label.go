@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// labelCmd represents the issues label command
+var labelCmd = &cobra.Command{
+	Use:   "label <issue>... [+label] [-label]",
+	Short: "Add and remove labels of issues",
+	Long: `Adds the labels prefixed with + and removes those prefixed with -:
+
+  linear-cli issues label ENG-1 +bug -triage
+  linear-cli issues label ENG-1 ENG-2 +Type/Bug`,
+	// Flag parsing is disabled so that -label is not taken for a flag.
+	DisableFlagParsing: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		var refs, added, removed []string
+		for _, arg := range args {
+			switch {
+			case arg == "-h" || arg == "--help":
+				cmd.Help()
+				return
+			case arg == "--":
+			case strings.HasPrefix(arg, "+"):
+				added = append(added, arg[1:])
+			case strings.HasPrefix(arg, "-"):
+				removed = append(removed, arg[1:])
+			default:
+				refs = append(refs, arg)
+			}
+		}
+		if len(refs) == 0 || len(added)+len(removed) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: give at least one issue and one +label or -label.")
+			os.Exit(1)
+		}
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		applyToIssues(newFieldResolver(apiKey), refs, func(issue *linear.IssueNode, update *issueUpdate) error {
+			return applyLabelChanges(update, nil, added, removed)
+		})
+	},
+}
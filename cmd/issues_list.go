@@ -22,6 +22,10 @@ var listCmd = &cobra.Command{
 		projectNameFromFlag, _ := cmd.Flags().GetString("project")
 		stateType, _ := cmd.Flags().GetString("state-type")
 		limit, _ := cmd.Flags().GetInt("limit")
+		blocked, _ := cmd.Flags().GetBool("blocked")
+		blocking, _ := cmd.Flags().GetBool("blocking")
+		archived, _ := cmd.Flags().GetBool("archived")
+		assignee, _ := cmd.Flags().GetString("assignee")
 
 		apiKey := config.GetAPIKey()
 		if apiKey == "" {
@@ -156,79 +160,55 @@ var listCmd = &cobra.Command{
 			}
 		}
 
-		// ... (Rest of the code to build the main issue query and variables) ...
-
-		query := `
-		query Issue($teamId: ID, $stateType: String, $first: Int) {
-			issues(filter: {team: {id: {eq: $teamId}}, state: {type: {eq: $stateType}}}, first: $first) {
-				nodes {
-					id
-					title
-					description
-					state {
-						id
-						name
-						type
-					}
-					assignee {
-						name
-						id
-					}
-					team {
-						id
-						name
-					}
-				}
-			}
-		}
-		`
-
-		variables := map[string]any{}
+		filter := map[string]any{}
 		if teamID != "" {
-			variables["teamId"] = teamID
+			filter["team"] = map[string]any{"id": map[string]any{"eq": teamID}}
+		}
+		if projectID != "" {
+			filter["project"] = map[string]any{"id": map[string]any{"eq": projectID}}
 		}
 		if stateType != "" {
-			variables["stateType"] = stateType
+			filter["state"] = map[string]any{"type": map[string]any{"eq": stateType}}
 		}
-		if limit > 0 {
-			variables["first"] = limit
-		} else {
-			variables["first"] = 50
+		if blocked {
+			filter["hasBlockedByRelations"] = map[string]any{"eq": true}
 		}
-		// Add projectID to variables ONLY if it was found
-		if projectID != "" {
-			variables["projectId"] = projectID
+		if blocking {
+			filter["hasBlockingRelations"] = map[string]any{"eq": true}
+		}
+		if assignee != "" {
+			assigneeFilter, err := linear.ParseFilter("assignee=" + quoteFilterValue(assignee))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			filter["assignee"] = assigneeFilter["assignee"]
+		}
+		if archived {
+			filter["archivedAt"] = map[string]any{"gt": "1970-01-01T00:00:00Z"}
+		}
+		if limit <= 0 {
+			limit = 50
 		}
 
 		fmt.Println("Fetching issues...")
 
-		data, err := api.MakeGraphQLRequest(apiKey, query, variables)
+		issues, err := linear.FetchIssues(apiKey, linear.IssueQuery{
+			Filter:          filter,
+			Limit:           limit,
+			Fields:          linear.IssueFields + linear.IssueRelationFields,
+			IncludeArchived: archived,
+		})
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error making GraphQL request: %v\n", err)
-			os.Exit(1)
-		}
-
-		var issuesResponse linear.IssuesResponseData
-		if err := json.Unmarshal(data, &issuesResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling issues data: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error fetching issues: %v\n", err)
 			os.Exit(1)
 		}
 
-		fmt.Printf("\nFound %d issues:\n", len(issuesResponse.Issues.Nodes))
-		if len(issuesResponse.Issues.Nodes) > 0 {
+		fmt.Printf("\nFound %d issues:\n", len(issues))
+		if len(issues) > 0 {
 			fmt.Println("--------------------")
-			for _, issue := range issuesResponse.Issues.Nodes {
-				fmt.Printf("  Issue ID: %s\n", issue.ID)
-				fmt.Printf("  Title: %s\n", issue.Title)
-				// You might want to truncate long descriptions
-				fmt.Printf("  Description: %s\n", issue.Description)
-				fmt.Printf("  Team: %s (%s)\n", issue.Team.Name, issue.Team.ID)
-				fmt.Printf("  State: %s (Type: %s)\n", issue.State.Name, issue.State.Type)
-				if issue.Assignee != nil {
-					fmt.Printf("  Assignee: %s (%s)\n", issue.Assignee.Name, issue.Assignee.ID)
-				} else {
-					fmt.Println("  Assignee: Unassigned")
-				}
+			for _, issue := range issues {
+				printIssue(issue)
 				fmt.Println("--------------------")
 			}
 		}
@@ -242,4 +222,8 @@ func init() {
 	listCmd.Flags().
 		StringP("state-type", "s", "", "Filter issues by State Type (e.g., 'started', 'completed')")
 	listCmd.Flags().IntP("limit", "l", 0, "Limit the number of results")
+	listCmd.Flags().Bool("blocked", false, "Only issues blocked by another issue")
+	listCmd.Flags().Bool("blocking", false, "Only issues blocking another issue")
+	listCmd.Flags().StringP("assignee", "a", "", "Filter issues by assignee: @me, none, an email or a name")
+	listCmd.Flags().Bool("archived", false, "Only archived and trashed issues")
 }
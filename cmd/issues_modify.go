@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
@@ -37,488 +39,555 @@ func promptForSelect(label string, items []string, defaultIndex int) (int, error
 	return index, err
 }
 
+// selectIssueInteractively walks the user through a team -> issue picker and
+// returns the ID of the chosen issue.
+func selectIssueInteractively(cmd *cobra.Command, apiKey string) string {
+	// Fetch teams
+	teamsQuery := `
+	query Teams {
+		teams {
+			nodes {
+				id
+				name
+			}
+		}
+	}
+	`
+	teamsData, err := api.MakeGraphQLRequest(apiKey, teamsQuery, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching teams: %v\n", err)
+		os.Exit(1)
+	}
+
+	var teamsResponse linear.TeamsResponseData
+	if err := json.Unmarshal(teamsData, &teamsResponse); err != nil {
+		fmt.Fprintf(os.Stderr, "Error unmarshalling teams data: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(teamsResponse.Teams.Nodes) == 0 {
+		fmt.Fprintln(os.Stderr, "No teams found. Cannot list issues.")
+		os.Exit(1)
+	}
+
+	teamNames := []string{}
+	teamMap := make(map[string]string)
+	for _, team := range teamsResponse.Teams.Nodes {
+		teamNames = append(teamNames, team.Name)
+		teamMap[team.Name] = team.ID
+	}
+
+	teamSelectPrompt := promptui.Select{
+		Label: "Select Team",
+		Items: teamNames,
+		Searcher: func(input string, index int) bool {
+			item := teamNames[index]
+			return strings.Contains(strings.ToLower(item), strings.ToLower(input))
+		},
+	}
+
+	_, selectedTeamName, err := teamSelectPrompt.Run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Team selection failed: %v\n", err)
+		if err == promptui.ErrInterrupt {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+	selectedTeamID := teamMap[selectedTeamName]
+
+	stateType, _ := cmd.Flags().GetString("state-type")
+	limit, _ := cmd.Flags().GetInt("limit")
+	includeClosed, _ := cmd.Flags().GetBool("include-closed")
+	if limit <= 0 {
+		limit = 50
+	}
+
+	// Closed issues are hidden by state type rather than name, so renamed
+	// or localized workflow states are handled too.
+	filter := map[string]any{"team": map[string]any{"id": map[string]any{"eq": selectedTeamID}}}
+	if stateType != "" {
+		filter["state"] = map[string]any{"type": map[string]any{"eq": stateType}}
+	} else if hidden := config.HiddenStateTypes(); !includeClosed && len(hidden) > 0 {
+		if err := linear.ValidateStateTypes(hidden); err != nil {
+			fmt.Fprintf(os.Stderr, "Error in HIDDEN_STATE_TYPES: %v\n", err)
+			os.Exit(1)
+		}
+		filter["state"] = map[string]any{"type": map[string]any{"nin": hidden}}
+	}
+
+	issues, err := linear.FetchIssues(apiKey, linear.IssueQuery{Filter: filter, Limit: limit})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching issues: %v\n", err)
+		os.Exit(1)
+	}
+
+	var issueDisplayItems []string
+	for _, issue := range issues {
+		display := fmt.Sprintf(
+			"%s: %s | Status: %s",
+			issue.Identifier,
+			issue.Title,
+			issue.State.Name,
+		)
+		issueDisplayItems = append(issueDisplayItems, display)
+	}
+
+	if len(issueDisplayItems) == 0 {
+		fmt.Println("No selectable issues found matching the criteria in the selected team.")
+		os.Exit(0)
+	}
+
+	issueSelectPrompt := promptui.Select{
+		Label: "Select Issue to Modify",
+		Items: issueDisplayItems,
+		Searcher: func(input string, index int) bool {
+			item := issueDisplayItems[index]
+			return strings.Contains(strings.ToLower(item), strings.ToLower(input))
+		},
+	}
+
+	selectedIndex, _, err := issueSelectPrompt.Run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Issue selection failed: %v\n", err)
+		if err == promptui.ErrInterrupt {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+
+	return issues[selectedIndex].ID
+}
+
+// modifyFields are the field names accepted by --interactive.
+var modifyFields = []string{
+	"title", "description", "project", "assignee", "state", "priority",
+	"labels", "estimate", "due", "cycle", "parent",
+}
+
+// modifyFieldFlags are the flags that change a field directly.
+var modifyFieldFlags = []string{
+	"title", "description", "description-file", "project", "assignee", "state",
+	"label", "add-label", "remove-label", "priority", "estimate", "due", "cycle", "parent",
+}
+
 var modifyCmd = &cobra.Command{
 	Use:   "modify [issue-id]",
 	Short: "Modify an existing Linear issue",
-	Long:  `Modifies an existing Linear issue identified by its ID.`,
+	Long: `Modifies an existing Linear issue. The issue can be given as an identifier
+(ENG-123 or eng-123), a bare number when DEFAULT_TEAM is set, a Linear issue
+URL or a UUID. Without an argument an interactive picker is shown.
+
+Fields are changed with flags, e.g.
+
+  linear-cli issues modify ENG-123 --state "In Review" --assignee @me \
+      --add-label bug --remove-label triage --priority high
+
+Use "none" to clear the project, assignee, estimate, due date, cycle or parent.
+--interactive prompts for the listed fields (or all fields when given without
+a value). Without any flags, all fields are prompted for. Only fields whose
+value actually changed are sent to Linear.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		format := outputFormat(output)
+
 		apiKey := config.GetAPIKey()
 		if apiKey == "" {
 			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
 			os.Exit(1)
 		}
 
-		// Fetch teams
-		teamsQuery := `
-		query Teams {
-			teams {
-				nodes {
-					id
-					name
-				}
-			}
+		flagsUsed := false
+		for _, name := range modifyFieldFlags {
+			flagsUsed = flagsUsed || cmd.Flags().Changed(name)
 		}
-		`
-		teamsData, err := api.MakeGraphQLRequest(apiKey, teamsQuery, nil)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching teams: %v\n", err)
-			os.Exit(1)
+		promptFields, _ := cmd.Flags().GetStringSlice("interactive")
+		if !flagsUsed && len(promptFields) == 0 {
+			promptFields = []string{"all"}
 		}
-
-		var teamsResponse linear.TeamsResponseData
-		if err := json.Unmarshal(teamsData, &teamsResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling teams data: %v\n", err)
+		if err := validateModifyFields(promptFields); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
-
-		if len(teamsResponse.Teams.Nodes) == 0 {
-			fmt.Fprintln(os.Stderr, "No teams found. Cannot list issues.")
+		if (len(promptFields) > 0 || len(args) == 0) && !stdinIsTerminal() {
+			fmt.Fprintln(os.Stderr, "Error: no terminal to prompt on; pass an issue and field flags.")
 			os.Exit(1)
 		}
 
-		teamNames := []string{}
-		teamMap := make(map[string]string)
-		for _, team := range teamsResponse.Teams.Nodes {
-			teamNames = append(teamNames, team.Name)
-			teamMap[team.Name] = team.ID
-		}
-
-		teamSelectPrompt := promptui.Select{
-			Label: "Select Team",
-			Items: teamNames,
-			Searcher: func(input string, index int) bool {
-				item := teamNames[index]
-				return strings.Contains(strings.ToLower(item), strings.ToLower(input))
-			},
+		var issueRef string
+		if len(args) > 0 {
+			issueRef = args[0]
+		} else {
+			issueRef = selectIssueInteractively(cmd, apiKey)
 		}
 
-		_, selectedTeamName, err := teamSelectPrompt.Run()
+		detailedIssue, err := linear.ResolveIssue(apiKey, issueRef, config.GetDefaultTeam())
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Team selection failed: %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
-			}
+			fmt.Fprintf(os.Stderr, "Error fetching issue details: %v\n", err)
 			os.Exit(1)
 		}
-		selectedTeamID := teamMap[selectedTeamName]
-
-		stateType, _ := cmd.Flags().GetString("state-type")
-		limit, _ := cmd.Flags().GetInt("limit")
-
-		issueQuery := `
-		query Issue($teamId: ID, $stateType: String, $first: Int) {
-			issues(filter: {team: {id: {eq: $teamId}}, state: {type: {eq: $stateType}}}, first: $first) {
-				nodes {
-					id
-					identifier
-					title
-					description
-					state {
-						id
-						name
-						type
-					}
-					assignee {
-						name
-						id
-					}
-					project {
-						id
-						name
-					}
-					team {
-						id
-						name
-					}
-				}
-			}
-		}
-		`
 
-		variables := map[string]any{
-			"teamId": selectedTeamID,
-		}
-		if stateType != "" {
-			variables["stateType"] = stateType
-		}
-		if limit > 0 {
-			variables["first"] = limit
-		} else {
-			variables["first"] = 50
-		}
-
-		data, err := api.MakeGraphQLRequest(apiKey, issueQuery, variables)
+		// Fetch projects, members, states, labels and cycles for the team
+		details, err := linear.FetchTeamDetails(apiKey, detailedIssue.Team.ID)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error making GraphQL request: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error fetching team details: %v\n", err)
 			os.Exit(1)
 		}
 
-		var issuesResponse linear.IssuesResponseData
-		if err := json.Unmarshal(data, &issuesResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling issues data: %v\n", err)
+		update := newIssueUpdate(detailedIssue, details)
+		if err := applyModifyFlags(cmd, newFieldResolver(apiKey), update); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
-		var issueDisplayItems []string
-		var selectableIssues []linear.IssueNode
-		for _, issue := range issuesResponse.Issues.Nodes {
-			if issue.State.Name == "Done" || issue.State.Name == "Canceled" {
-				continue
-			}
-			display := fmt.Sprintf(
-				"%s: %s | Status: %s",
-				issue.Identifier,
-				issue.Title,
-				issue.State.Name,
-			)
-			issueDisplayItems = append(issueDisplayItems, display)
-			selectableIssues = append(selectableIssues, issue)
-		}
-
-		if len(issueDisplayItems) == 0 {
-			fmt.Println("No selectable issues found matching the criteria in the selected team.")
-			os.Exit(0)
+		if len(promptFields) > 0 {
+			fmt.Println("--------------------")
+			fmt.Println("Current Issue Details:")
+			printIssue(*detailedIssue)
+			fmt.Println("--------------------")
+			promptModifyFields(apiKey, update, promptFields)
 		}
 
-		issueSelectPrompt := promptui.Select{
-			Label: "Select Issue to Modify",
-			Items: issueDisplayItems,
-			Searcher: func(input string, index int) bool {
-				item := issueDisplayItems[index]
-				return strings.Contains(strings.ToLower(item), strings.ToLower(input))
-			},
-		}
-
-		selectedIndex, _, err := issueSelectPrompt.Run()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Issue selection failed: %v\n", err)
-			if err == promptui.ErrInterrupt {
-				os.Exit(0)
-			}
-			os.Exit(1)
+		if update.empty() {
+			fmt.Fprintln(os.Stderr, "No changes.")
+			return
 		}
 
-		selectedIssue := selectableIssues[selectedIndex]
-
-		// Fetch detailed info for selected issue
-		selectedIssueQuery := `
-		query IssueByID($id: String!) {
-			issue(id: $id) {
-				id
-				identifier
-				title
-				description
-				project {
-					id
-					name
-				}
-				assignee {
-					id
-					name
-				}
-				state {
-					id
-					name
-					type
-				}
-			}
+		fmt.Fprintf(os.Stderr, "Updating %s:\n", detailedIssue.Identifier)
+		for _, line := range update.summary() {
+			fmt.Fprintf(os.Stderr, "  %s\n", line)
 		}
-		`
 
-		issueVariables := map[string]any{
-			"id": selectedIssue.ID,
+		updatedIssue, err := saveIssueUpdate(apiKey, update, stdinIsTerminal())
+		if err == errUpdateAborted {
+			fmt.Fprintln(os.Stderr, "Aborted.")
+			os.Exit(1)
 		}
-
-		data, err = api.MakeGraphQLRequest(apiKey, selectedIssueQuery, issueVariables)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching issue details: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error updating issue: %v\n", err)
 			os.Exit(1)
 		}
 
-		var issueDetailResponse struct {
-			Issue linear.IssueNode `json:"issue"`
+		if format == "json" {
+			printJSON(updatedIssue)
+			return
 		}
+		fmt.Println("Issue updated successfully!")
+		fmt.Printf("Updated Issue:\n Identifier: %s\n Title: %s\n Status: %s\n",
+			updatedIssue.Identifier,
+			updatedIssue.Title,
+			updatedIssue.State.Name,
+		)
+	},
+}
 
-		if err := json.Unmarshal(data, &issueDetailResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling issue details: %v\n", err)
-			os.Exit(1)
+func validateModifyFields(fields []string) error {
+	for _, field := range fields {
+		if field == "all" || slices.Contains(modifyFields, field) {
+			continue
 		}
+		return fmt.Errorf("unknown field %q for --interactive (expected %s)", field, strings.Join(modifyFields, ", "))
+	}
+	return nil
+}
 
-		detailedIssue := issueDetailResponse.Issue
+// isNone reports whether a flag value asks to clear the field.
+func isNone(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "none", "unassigned", "-":
+		return true
+	}
+	return false
+}
 
-		projectName := ""
-		if detailedIssue.Project != nil {
-			projectName = detailedIssue.Project.Name
-		}
-		assigneeName := ""
-		if detailedIssue.Assignee != nil {
-			assigneeName = detailedIssue.Assignee.Name
-		}
+// applyModifyFlags records the changes requested by flags in update.
+func applyModifyFlags(cmd *cobra.Command, r *fieldResolver, update *issueUpdate) error {
+	flags := cmd.Flags()
 
-		fmt.Println("--------------------")
-		fmt.Printf(
-			"Current Issue Details:\n ID: %s\n Title: %s\n Description: %s\n Project: %s\n Assignee: %s\n Status: %s\n",
-			detailedIssue.Identifier,
-			detailedIssue.Title,
-			detailedIssue.Description,
-			projectName,
-			assigneeName,
-			detailedIssue.State.Name,
-		)
-		fmt.Println("--------------------")
-
-		// Fetch projects for team to select new project
-		projectsQuery := `
-		query TeamProjects($teamId: String!) {
-			team(id: $teamId) {
-				projects {
-					nodes {
-						id
-						name
-					}
-				}
+	for _, field := range []string{"title", "description", "project", "assignee", "state", "priority"} {
+		if flags.Changed(field) {
+			value, _ := flags.GetString(field)
+			if err := applyFieldValue(r, update, field, value); err != nil {
+				return err
 			}
 		}
-		`
-		projectVars := map[string]any{"teamId": selectedTeamID}
-		projectData, err := api.MakeGraphQLRequest(apiKey, projectsQuery, projectVars)
+	}
+	if flags.Changed("description-file") {
+		path, _ := flags.GetString("description-file")
+		content, err := os.ReadFile(path)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching projects: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("reading description file: %w", err)
 		}
+		update.setDescription(string(content))
+	}
 
-		var projectsResponse struct {
-			Team struct {
-				Projects struct {
-					Nodes []linear.ProjectNode `json:"nodes"`
-				} `json:"projects"`
-			} `json:"team"`
+	if flags.Changed("label") || flags.Changed("add-label") || flags.Changed("remove-label") {
+		var replace []string
+		if flags.Changed("label") {
+			replace, _ = flags.GetStringArray("label")
+			if replace == nil {
+				replace = []string{}
+			}
 		}
-		if err := json.Unmarshal(projectData, &projectsResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling projects data: %v\n", err)
-			os.Exit(1)
+		added, _ := flags.GetStringArray("add-label")
+		removed, _ := flags.GetStringArray("remove-label")
+		if err := applyLabelChanges(update, replace, added, removed); err != nil {
+			return err
 		}
+	}
 
-		// Fetch users (assignees) for team
-		usersQuery := `
-		query TeamUsers($teamId: String!) {
-			team(id: $teamId) {
-				members {
-					nodes {
-						id
-						name
-					}
-				}
+	for _, field := range []string{"estimate", "due", "cycle", "parent"} {
+		if flags.Changed(field) {
+			value, _ := flags.GetString(field)
+			if err := applyFieldValue(r, update, field, value); err != nil {
+				return err
 			}
 		}
-		`
-		userData, err := api.MakeGraphQLRequest(apiKey, usersQuery, projectVars)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching users: %v\n", err)
-			os.Exit(1)
-		}
+	}
 
-		var usersResponse struct {
-			Team struct {
-				Members struct {
-					Nodes []linear.UserNode `json:"nodes"`
-				} `json:"members"`
-			} `json:"team"`
-		}
-		if err := json.Unmarshal(userData, &usersResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling users data: %v\n", err)
-			os.Exit(1)
+	return nil
+}
+
+// applyFieldValue records a change of a single-valued field given as text,
+// as in "--state Done" or "--set state=Done". "none" clears optional fields.
+func applyFieldValue(r *fieldResolver, update *issueUpdate, field, value string) error {
+	details := update.details
+
+	switch field {
+	case "title":
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("title cannot be empty")
 		}
+		update.setTitle(value)
 
-		// Fetch states for team
-		statesQuery := `
-		query TeamStates($teamId: String!) {
-			team(id: $teamId) {
-				states {
-					nodes {
-						id
-						name
-						type
-					}
-				}
-			}
+	case "description":
+		update.setDescription(value)
+
+	case "project":
+		if isNone(value) {
+			update.setProjectID("")
+			return nil
 		}
-		`
-		stateData, err := api.MakeGraphQLRequest(apiKey, statesQuery, projectVars)
+		project, err := details.FindProject(value)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching states: %v\n", err)
-			os.Exit(1)
+			return err
 		}
+		update.setProjectID(project.ID)
 
-		var statesResponse struct {
-			Team struct {
-				States struct {
-					Nodes []linear.StateNode `json:"nodes"`
-				} `json:"states"`
-			} `json:"team"`
+	case "assignee":
+		if isNone(value) {
+			update.setAssignee("", "")
+			return nil
 		}
-		if err := json.Unmarshal(stateData, &statesResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling states data: %v\n", err)
-			os.Exit(1)
+		user, err := r.member(details, value)
+		if err != nil {
+			return err
 		}
+		update.setAssignee(user.ID, user.Name)
 
-		// Prompt user for new values, allowing to keep existing values
-
-		newTitle, err := promptForString("Title", detailedIssue.Title)
+	case "state":
+		state, err := details.FindState(value)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Prompt failed: %v\n", err)
-			os.Exit(1)
+			return err
 		}
+		update.setStateID(state.ID)
 
-		newDescription, err := promptForString("Description", detailedIssue.Description)
+	case "priority":
+		priority, err := linear.ParsePriority(value)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Prompt failed: %v\n", err)
-			os.Exit(1)
+			return err
 		}
+		update.setPriority(priority)
 
-		// Project selection
-		projectNames := make([]string, len(projectsResponse.Team.Projects.Nodes))
-		projectDefault := 0
-		for i, p := range projectsResponse.Team.Projects.Nodes {
-			projectNames[i] = p.Name
-			if detailedIssue.Project != nil && p.ID == detailedIssue.Project.ID {
-				projectDefault = i
-			}
+	case "estimate":
+		if isNone(value) {
+			update.setEstimate(nil)
+			return nil
 		}
-		selectedProjectIndex, err := promptForSelect("Select Project", projectNames, projectDefault)
+		estimate, err := details.ParseEstimate(value)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Project selection failed: %v\n", err)
-			os.Exit(1)
+			return err
 		}
-		newProjectID := projectsResponse.Team.Projects.Nodes[selectedProjectIndex].ID
+		update.setEstimate(&estimate)
 
-		// Assignee selection (include Unassigned option)
-		assigneeNames := make([]string, len(usersResponse.Team.Members.Nodes)+1)
-		assigneeNames[0] = "Unassigned"
-		assigneeDefault := 0
-		for i, u := range usersResponse.Team.Members.Nodes {
-			assigneeNames[i+1] = u.Name
-			if detailedIssue.Assignee != nil && u.ID == detailedIssue.Assignee.ID {
-				assigneeDefault = i + 1
-			}
+	case "due":
+		if isNone(value) {
+			update.setDueDate("")
+			return nil
 		}
-		selectedAssigneeIndex, err := promptForSelect(
-			"Select Assignee",
-			assigneeNames,
-			assigneeDefault,
-		)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Assignee selection failed: %v\n", err)
-			os.Exit(1)
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return fmt.Errorf("invalid due date %q: expected YYYY-MM-DD", value)
 		}
+		update.setDueDate(value)
 
-		var newAssigneeID *string
-		if selectedAssigneeIndex == 0 {
-			// Unassigned
-			newAssigneeID = nil
-		} else {
-			id := usersResponse.Team.Members.Nodes[selectedAssigneeIndex-1].ID
-			newAssigneeID = &id
+	case "cycle":
+		if isNone(value) {
+			update.setCycleID("")
+			return nil
+		}
+		cycle, err := details.FindCycle(value)
+		if err != nil {
+			return err
 		}
+		update.setCycleID(cycle.ID)
 
-		// State selection
-		stateNames := make([]string, len(statesResponse.Team.States.Nodes))
-		stateDefault := 0
-		for i, s := range statesResponse.Team.States.Nodes {
-			stateNames[i] = s.Name
-			if detailedIssue.State.ID == s.ID {
-				stateDefault = i
-			}
+	case "parent":
+		if isNone(value) {
+			update.setParent(nil)
+			return nil
 		}
-		selectedStateIndex, err := promptForSelect("Select Status", stateNames, stateDefault)
+		parent, err := linear.ResolveIssue(r.apiKey, value, config.GetDefaultTeam())
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Status selection failed: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("resolving parent issue: %w", err)
 		}
-		newStateID := statesResponse.Team.States.Nodes[selectedStateIndex].ID
-
-		// Prepare mutation
-		updateMutation := `
-		mutation IssueUpdate(
-			$id: String!,
-			$title: String,
-			$description: String,
-			$projectId: String,
-			$assigneeId: String,
-			$stateId: String
-		) {
-			issueUpdate(
-				id: $id,
-				input: {
-					title: $title,
-					description: $description,
-					projectId: $projectId,
-					assigneeId: $assigneeId,
-					stateId: $stateId
-				}
-			) {
-				success
-				issue {
-					identifier
-					title
-					description
-					project {
-						name
-					}
-					assignee {
-						name
-					}
-					state {
-						name
-					}
+		update.setParent(parent)
+
+	default:
+		return fmt.Errorf("unknown field '%s'", field)
+	}
+	return nil
+}
+
+// applyLabelChanges records a label change: replace, when not nil, becomes
+// the new label set, then added labels are added and removed ones removed.
+func applyLabelChanges(update *issueUpdate, replace, added, removed []string) error {
+	details := update.details
+	labelIDs := update.issue.LabelIDs()
+	if replace != nil {
+		ids, err := findLabelIDs(details, replace)
+		if err != nil {
+			return err
+		}
+		labelIDs = ids
+	}
+	addIDs, err := findLabelIDs(details, added)
+	if err != nil {
+		return err
+	}
+	for _, id := range addIDs {
+		if !slices.Contains(labelIDs, id) {
+			labelIDs = append(labelIDs, id)
+		}
+	}
+	for _, name := range removed {
+		before := len(labelIDs)
+		labelIDs = slices.DeleteFunc(labelIDs, func(id string) bool {
+			for _, label := range update.issue.Labels.Nodes {
+				if label.ID == id && strings.EqualFold(label.Name, name) {
+					return true
 				}
 			}
+			label, err := details.FindLabel(name)
+			return err == nil && label.ID == id
+		})
+		if len(labelIDs) == before {
+			fmt.Fprintf(os.Stderr, "Warning: %s does not have label '%s'\n", update.issue.Identifier, name)
 		}
-		`
+	}
+	update.setLabelIDs(labelIDs)
+	return nil
+}
 
-		mutationVariables := map[string]any{
-			"id":          detailedIssue.ID,
-			"title":       newTitle,
-			"description": newDescription,
-			"projectId":   newProjectID,
-			"assigneeId":  newAssigneeID,
-			"stateId":     newStateID,
+func findLabelIDs(details *linear.TeamDetails, names []string) ([]string, error) {
+	ids := []string{}
+	for _, name := range names {
+		label, err := details.FindLabel(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
 		}
+		ids = append(ids, label.ID)
+	}
+	return ids, nil
+}
+
+// promptModifyFields prompts for the given fields ("all" for every field),
+// preselecting the current values, and records what changed.
+func promptModifyFields(apiKey string, update *issueUpdate, fields []string) {
+	issue := update.issue
+	details := update.details
+	wants := func(field string) bool {
+		return slices.Contains(fields, "all") || slices.Contains(fields, field)
+	}
 
-		mutationData, err := api.MakeGraphQLRequest(apiKey, updateMutation, mutationVariables)
+	if wants("title") {
+		newTitle, err := promptForString("Title", issue.Title)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error updating issue: %v\n", err)
-			os.Exit(1)
+			exitOnPromptError("Prompt", err)
 		}
-
-		var mutationResponse struct {
-			IssueUpdate struct {
-				Success bool             `json:"success"`
-				Issue   linear.IssueNode `json:"issue"`
-			} `json:"issueUpdate"`
+		if strings.TrimSpace(newTitle) != "" {
+			update.setTitle(newTitle)
 		}
-
-		if err := json.Unmarshal(mutationData, &mutationResponse); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshalling mutation response: %v\n", err)
-			os.Exit(1)
+	}
+	if wants("description") {
+		newDescription, err := promptForString("Description", issue.Description)
+		if err != nil {
+			exitOnPromptError("Prompt", err)
 		}
-
-		if mutationResponse.IssueUpdate.Success {
-			fmt.Println("Issue updated successfully!")
-			fmt.Printf("Updated Issue:\n Identifier: %s\n Title: %s\n Status: %s\n",
-				mutationResponse.IssueUpdate.Issue.Identifier,
-				mutationResponse.IssueUpdate.Issue.Title,
-				mutationResponse.IssueUpdate.Issue.State.Name,
-			)
-		} else {
-			fmt.Println("Issue update failed.")
-			os.Exit(1)
+		update.setDescription(newDescription)
+	}
+	if wants("project") {
+		update.setProjectID(promptProjectID(details, issue.ProjectID()))
+	}
+	if wants("assignee") {
+		update.setAssignee(promptAssigneeID(details, issue.AssigneeID()), "")
+	}
+	if wants("state") {
+		update.setStateID(promptStateID(details, issue.State.ID))
+	}
+	if wants("priority") {
+		update.setPriority(promptPriority(issue.Priority))
+	}
+	if wants("labels") {
+		update.setLabelIDs(promptLabelIDs(details, issue.LabelIDs()))
+	}
+	if wants("estimate") {
+		update.setEstimate(promptEstimate(details, issue.EstimateValue()))
+	}
+	if wants("due") {
+		update.setDueDate(promptDueDate(issue.DueDate))
+	}
+	if wants("cycle") {
+		update.setCycleID(promptCycleID(details, issue.CycleID()))
+	}
+	if wants("parent") {
+		current := ""
+		if issue.Parent != nil {
+			current = issue.Parent.Identifier
 		}
-	},
+		update.setParent(promptParent(apiKey, current))
+	}
+}
+
+// nilIfEmpty maps "" to nil so that optional IDs are cleared in mutations.
+func nilIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
 }
 
 func init() {
 	modifyCmd.Flags().
 		StringP("state-type", "s", "", "Filter issues by state type (e.g., 'backlog', 'unstarted', 'started', 'completed', 'canceled')")
 	modifyCmd.Flags().IntP("limit", "l", 50, "Limit the number of issues fetched")
+	modifyCmd.Flags().Bool("include-closed", false, "Also list issues in hidden (by default completed and canceled) states")
+
+	modifyCmd.Flags().String("title", "", "New title")
+	modifyCmd.Flags().String("description", "", "New description (markdown)")
+	modifyCmd.Flags().String("description-file", "", "Read the new description from a file")
+	modifyCmd.Flags().StringP("project", "p", "", "Project name, or 'none'")
+	modifyCmd.Flags().String("assignee", "", "Assignee name, email or @me, or 'none'")
+	modifyCmd.Flags().String("state", "", "Workflow state name")
+	modifyCmd.Flags().StringArray("label", nil, "Replace all labels (repeatable)")
+	modifyCmd.Flags().StringArray("add-label", nil, "Add a label (repeatable)")
+	modifyCmd.Flags().StringArray("remove-label", nil, "Remove a label (repeatable)")
+	modifyCmd.Flags().String("priority", "", "Priority: urgent, high, medium, low or none")
+	modifyCmd.Flags().String("estimate", "", "Estimate in the team's scale, or 'none'")
+	modifyCmd.Flags().String("due", "", "Due date (YYYY-MM-DD), or 'none'")
+	modifyCmd.Flags().String("cycle", "", "Cycle number, name or 'current', or 'none'")
+	modifyCmd.Flags().String("parent", "", "Parent issue (e.g. ENG-123), or 'none'")
+	modifyCmd.Flags().StringSliceP("interactive", "i", nil, "Prompt for these fields (comma-separated), or all fields if no value is given")
+	modifyCmd.Flags().Lookup("interactive").NoOptDefVal = "all"
+	modifyCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
 }
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/emoji"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// reactCmd represents the issues react command
+var reactCmd = &cobra.Command{
+	Use:   "react <issue> <emoji>",
+	Short: "React to an issue with an emoji",
+	Long: `Adds an emoji reaction to an issue, or removes yours with --remove. The
+emoji is a shortcode such as :+1: or :eyes: (the colons are optional) or the
+emoji itself. Comments are reacted to with
+
+  linear-cli comments react <comment-id> <emoji>
+
+Reactions are journaled and can be reverted with 'linear-cli undo'.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		remove, _ := cmd.Flags().GetBool("remove")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		issue, err := linear.ResolveIssueFields(apiKey, args[0], config.GetDefaultTeam(), linear.IssueFields+linear.IssueReactionFields)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+		input := linear.ReactionCreateInput{IssueID: issue.ID}
+		ref := linear.IssueRef{ID: issue.ID, Identifier: issue.Identifier}
+		if err := react(apiKey, input, issue.Reactions, args[1], ref, remove); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// commentReactCmd represents the comments react command
+var commentReactCmd = &cobra.Command{
+	Use:   "react <comment-id> <emoji>",
+	Short: "React to a comment with an emoji",
+	Long: `Adds an emoji reaction to a comment, or removes yours with --remove. The
+emoji is given like for 'issues react':
+
+  linear-cli comments react <comment-id> :eyes:
+
+The comment IDs are shown by 'linear-cli comments list <issue>'. This is
+also available as 'issues comment react'.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		remove, _ := cmd.Flags().GetBool("remove")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		comment, err := linear.FetchComment(apiKey, args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		input := linear.ReactionCreateInput{CommentID: comment.ID}
+		if err := react(apiKey, input, comment.Reactions, args[1], *comment.Issue, remove); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// react adds the current user's reaction to the issue or comment of input,
+// or removes it. existing are the reactions it already has; issue is the
+// issue reacted to, or the comment's issue.
+func react(apiKey string, input linear.ReactionCreateInput, existing []linear.ReactionNode, value string, issue linear.IssueRef, remove bool) error {
+	name := emoji.Name(value)
+	if name == "" {
+		return fmt.Errorf("no emoji given")
+	}
+	target := issue.Identifier
+	if input.CommentID != "" {
+		target = "a comment on " + issue.Identifier
+	}
+	input.Emoji = name
+	viewer, err := currentUser(apiKey)
+	if err != nil {
+		return err
+	}
+
+	var own *linear.ReactionNode
+	for i, reaction := range existing {
+		if reaction.User != nil && reaction.User.ID == viewer.ID && emoji.Name(reaction.Emoji) == name {
+			own = &existing[i]
+		}
+	}
+
+	if remove {
+		if own == nil {
+			return fmt.Errorf("you have not reacted with %s to %s", emoji.Unicode(name), target)
+		}
+		if err := deleteReaction(apiKey, own.ID, input, issue); err != nil {
+			return err
+		}
+		fmt.Printf("Removed %s from %s\n", emoji.Unicode(name), target)
+		return nil
+	}
+
+	if own != nil {
+		fmt.Printf("You already reacted with %s to %s\n", emoji.Unicode(name), target)
+		return nil
+	}
+	if _, err := createReaction(apiKey, input, issue); err != nil {
+		return err
+	}
+	fmt.Printf("Reacted %s to %s\n", emoji.Unicode(name), target)
+	return nil
+}
+
+// formatReactions renders reactions aggregated by emoji, e.g. "👍 2  👀 1".
+func formatReactions(reactions []linear.ReactionNode) string {
+	counts := linear.CountReactions(reactions)
+	parts := make([]string, len(counts))
+	for i, count := range counts {
+		parts[i] = fmt.Sprintf("%s %d", emoji.Unicode(count.Emoji), count.Count)
+	}
+	return strings.Join(parts, "  ")
+}
+
+func init() {
+	reactCmd.Flags().Bool("remove", false, "Remove your reaction instead")
+}
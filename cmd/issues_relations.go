@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// duplicateTransition picks the state a duplicate is closed with: the
+// canceled state named like "Duplicate", configurable with DUPLICATE_STATE.
+var duplicateTransition = transition{name: "duplicate", stateTypes: []string{"canceled"}, nameHint: "duplicate"}
+
+// relateCmd represents the issues relate command
+var relateCmd = &cobra.Command{
+	Use:   "relate <issue> <blocks|blocked-by|duplicates|related> <issue>",
+	Short: "Relate two issues",
+	Long: `Creates a relation between two issues:
+
+  linear-cli issues relate ENG-1 blocks ENG-2
+  linear-cli issues relate ENG-1 blocked-by ENG-2
+  linear-cli issues relate ENG-1 duplicates ENG-3
+  linear-cli issues relate ENG-1 related ENG-4
+
+Like in Linear, marking an issue as a duplicate also closes it, moving it to
+the team's canceled state named like "Duplicate" (or DUPLICATE_STATE), or
+the first canceled state. Use --keep-open to leave it open.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		keepOpen, _ := cmd.Flags().GetBool("keep-open")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		relationType, inverse, err := linear.ParseRelationVerb(args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		issue, related := resolveIssuePair(apiKey, args[0], args[2])
+		if inverse {
+			issue, related = related, issue
+		}
+		if issue.ID == related.ID {
+			fmt.Fprintln(os.Stderr, "Error: an issue cannot be related to itself.")
+			os.Exit(1)
+		}
+
+		if _, err := createRelation(apiKey, issue, related, relationType); err != nil {
+			fmt.Fprintf(os.Stderr, "Error relating issues: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("%s %s %s\n", args[0], args[1], args[2])
+
+		if relationType == "duplicate" && !keepOpen {
+			if err := closeDuplicate(apiKey, issue); err != nil {
+				fmt.Fprintf(os.Stderr, "Error closing duplicate %s: %v\n", issue.Identifier, err)
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+// closeDuplicate moves a duplicate issue to its team's duplicate state.
+func closeDuplicate(apiKey string, issue *linear.IssueNode) error {
+	if issue.State.Type == "completed" || issue.State.Type == "canceled" {
+		return nil
+	}
+	states, err := teamWorkflowStates(apiKey, issue.Team.ID, false)
+	if err != nil {
+		return err
+	}
+	state, err := duplicateTransition.target(states, issue.Team.Key)
+	if err != nil {
+		if state, err = (transition{name: "cancel", stateTypes: []string{"canceled"}}).target(states, issue.Team.Key); err != nil {
+			return err
+		}
+	}
+
+	details := &linear.TeamDetails{ID: issue.Team.ID, Key: issue.Team.Key, Name: issue.Team.Name, States: states}
+	update := newIssueUpdate(issue, details)
+	update.setStateID(state.ID)
+	if _, err := saveIssueUpdate(apiKey, update, stdinIsTerminal()); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s -> %s\n", issue.Identifier, issue.State.Name, state.Name)
+	return nil
+}
+
+// unrelateCmd represents the issues unrelate command
+var unrelateCmd = &cobra.Command{
+	Use:   "unrelate <issue> [relation] <issue>",
+	Short: "Remove the relations between two issues",
+	Long: `Removes the relations between two issues, in either direction. Give a
+relation (blocks, blocked-by, duplicates, related) to remove only that one.`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		relationType, inverse, other := "", false, args[len(args)-1]
+		if len(args) == 3 {
+			var err error
+			if relationType, inverse, err = linear.ParseRelationVerb(args[1]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		issue, err := linear.ResolveIssueFields(apiKey, args[0], config.GetDefaultTeam(), linear.IssueFields+linear.IssueRelationFields)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+		related, err := linear.ResolveIssue(apiKey, other, config.GetDefaultTeam())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+
+		removed := 0
+		for _, relation := range issue.IssueRelations() {
+			if relation.Issue.ID != related.ID {
+				continue
+			}
+			if relationType != "" && (relation.Type != relationType ||
+				(relationType != "related" && relation.Inverse != inverse)) {
+				continue
+			}
+			if err := deleteRelation(apiKey, issue, relation); err != nil {
+				fmt.Fprintf(os.Stderr, "Error removing relation: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Removed: %s %s %s\n", issue.Identifier, relation.Kind, related.Identifier)
+			removed++
+		}
+		if removed == 0 {
+			fmt.Fprintf(os.Stderr, "No matching relation between %s and %s.\n", issue.Identifier, related.Identifier)
+			os.Exit(1)
+		}
+	},
+}
+
+// relationsCmd represents the issues relations command
+var relationsCmd = &cobra.Command{
+	Use:   "relations <issue>",
+	Short: "List the relations of an issue",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		issue, err := linear.ResolveIssueFields(apiKey, args[0], config.GetDefaultTeam(), linear.IssueFields+linear.IssueRelationFields)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+		relations := issue.IssueRelations()
+
+		if format == "json" {
+			if relations == nil {
+				relations = []linear.Relation{}
+			}
+			printJSON(relations)
+			return
+		}
+		if len(relations) == 0 {
+			fmt.Printf("%s has no relations.\n", issue.Identifier)
+			return
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "RELATION\tISSUE\tSTATE\tTITLE\t")
+		for _, relation := range relations {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+				relation.Kind, relation.Issue.Identifier, relation.Issue.State.Name, relation.Issue.Title)
+		}
+		w.Flush()
+	},
+}
+
+// resolveIssuePair resolves two issue references, exiting on failure.
+func resolveIssuePair(apiKey, a, b string) (*linear.IssueNode, *linear.IssueNode) {
+	first, err := linear.ResolveIssue(apiKey, a, config.GetDefaultTeam())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+		os.Exit(1)
+	}
+	second, err := linear.ResolveIssue(apiKey, b, config.GetDefaultTeam())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+		os.Exit(1)
+	}
+	return first, second
+}
+
+func init() {
+	relateCmd.Flags().Bool("keep-open", false, "Do not close an issue marked as a duplicate")
+	relationsCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+}
@@ -0,0 +1,292 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// transferPlan is the planned move of one issue to another team.
+type transferPlan struct {
+	result *bulkResult
+	// missing are the issue's labels the target team does not have.
+	missing  []linear.LabelNode
+	warnings []string
+}
+
+// transferCmd represents the issues transfer command
+var transferCmd = &cobra.Command{
+	Use:   "transfer [issue...] --to-team <team>",
+	Short: "Move issues to another team",
+	Long: `Moves issues, given by identifier and/or a --filter expression (see
+'issues bulk-update --help'), to another team:
+
+  linear-cli issues transfer ENG-12 --to-team Platform
+  linear-cli issues transfer --filter 'team=ENG label=infra' --to-team Platform --dry-run
+
+Each issue keeps its workflow state type: it moves to the target team's state
+of the same type, preferring one with the same name. Labels the target team
+does not have are dropped, or created in the target team with
+--missing-labels create. Cycles belong to a team, so issues leave their
+cycle, and they leave projects the target team is not part of; both are
+reported before anything is changed.
+
+The planned changes are printed and you are asked to confirm unless --yes is
+given; --dry-run only prints them. Transfers are journaled and can be
+reverted with 'linear-cli undo', as can the creation of missing labels:
+they are journaled just before the issues move, so undoing the whole
+transfer deletes them again.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		teamName, _ := cmd.Flags().GetString("to-team")
+		filterExpr, _ := cmd.Flags().GetString("filter")
+		missingLabels, _ := cmd.Flags().GetString("missing-labels")
+		limit, _ := cmd.Flags().GetInt("limit")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		yes, _ := cmd.Flags().GetBool("yes")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+		if teamName == "" {
+			fmt.Fprintln(os.Stderr, "Error: --to-team is required.")
+			os.Exit(1)
+		}
+		if missingLabels != "drop" && missingLabels != "create" {
+			fmt.Fprintf(os.Stderr, "Error: unknown --missing-labels '%s' (expected drop or create)\n", missingLabels)
+			os.Exit(1)
+		}
+		createLabels := missingLabels == "create"
+		if concurrency < 1 {
+			concurrency = 1
+		}
+
+		resolver := newFieldResolver(apiKey)
+		team, err := resolver.team(teamName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		target, err := resolver.teamDetails(team.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		issues, err := selectIssues(apiKey, args, filterExpr, linear.IssueQuery{Limit: limit})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if len(issues) == 0 {
+			fmt.Fprintln(os.Stderr, "No issues match.")
+			return
+		}
+
+		plans := planTransfers(issues, target, createLabels)
+		printTransferPlan(plans, target, createLabels)
+
+		if dryRun {
+			fmt.Fprintln(os.Stderr, "Dry run: nothing was changed.")
+			return
+		}
+		if !yes {
+			if !stdinIsTerminal() {
+				fmt.Fprintln(os.Stderr, "Error: confirmation required; re-run with --yes or --dry-run.")
+				os.Exit(1)
+			}
+			if !confirm(fmt.Sprintf("Transfer %d issue(s) to %s", len(issues), target.Name)) {
+				fmt.Fprintln(os.Stderr, "Aborted.")
+				return
+			}
+		}
+
+		if createLabels {
+			created, err := createMissingLabels(apiKey, plans, target)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			if created > 0 {
+				plans = planTransfers(issues, target, createLabels)
+			}
+		}
+
+		results := make([]*bulkResult, len(plans))
+		for i, plan := range plans {
+			results[i] = plan.result
+		}
+		applyBulkUpdates(apiKey, results, concurrency)
+		for _, result := range results {
+			if result.Applied && result.updated != nil && result.updated.Identifier != result.Identifier {
+				fmt.Printf("%s -> %s\n", result.Identifier, result.updated.Identifier)
+			}
+		}
+
+		if failed := summarizeBulkUpdate(results); len(failed) > 0 {
+			retry := append([]string{"linear-cli", "issues", "transfer"}, failed...)
+			retry = append(retry, "--to-team", shellQuote(teamName), "--missing-labels", missingLabels, "--yes")
+			fmt.Fprintf(os.Stderr, "\nTo retry the failed issues:\n  %s\n", strings.Join(retry, " "))
+			os.Exit(1)
+		}
+	},
+}
+
+func planTransfers(issues []linear.IssueNode, target *linear.TeamDetails, createLabels bool) []*transferPlan {
+	plans := make([]*transferPlan, len(issues))
+	for i := range issues {
+		plans[i] = planTransfer(&issues[i], target, createLabels)
+	}
+	return plans
+}
+
+// planTransfer works out the changes that move an issue to the target team.
+func planTransfer(issue *linear.IssueNode, target *linear.TeamDetails, createLabels bool) *transferPlan {
+	plan := &transferPlan{result: &bulkResult{Identifier: issue.Identifier, Title: issue.Title}}
+	update := newIssueUpdate(issue, target)
+	plan.result.update = update
+	if issue.Team.ID == target.ID {
+		return plan
+	}
+
+	state, err := transferState(target, issue.State.Name, issue.State.Type)
+	if err != nil {
+		plan.result.Error = err.Error()
+		plan.result.update = nil
+		return plan
+	}
+	update.setTeam(target.ID, target.Name)
+	update.setStateID(state.ID)
+
+	labelIDs := []string{}
+	for _, label := range issue.Labels.Nodes {
+		if mapped := transferLabel(target, label); mapped != nil {
+			labelIDs = append(labelIDs, mapped.ID)
+			continue
+		}
+		plan.missing = append(plan.missing, label)
+		if !createLabels {
+			plan.warnings = append(plan.warnings,
+				fmt.Sprintf("%s will lose label '%s', which %s does not have", issue.Identifier, label.Name, target.Name))
+		}
+	}
+	update.setLabelIDs(labelIDs)
+
+	if issue.Cycle != nil {
+		plan.warnings = append(plan.warnings,
+			fmt.Sprintf("%s will leave cycle %s", issue.Identifier, issue.Cycle.Label()))
+		update.setCycleID("")
+	}
+	if issue.Project != nil {
+		if _, err := target.FindProject(issue.Project.ID); err != nil {
+			plan.warnings = append(plan.warnings,
+				fmt.Sprintf("%s will leave project '%s', which %s is not part of", issue.Identifier, issue.Project.Name, target.Name))
+			update.setProjectID("")
+		}
+	}
+
+	plan.result.Changes = update.changes
+	return plan
+}
+
+// transferState returns the target team's state of the given type,
+// preferring one with the same name.
+func transferState(target *linear.TeamDetails, name, stateType string) (*linear.StateNode, error) {
+	candidates := linear.WorkflowStates(target.States).OfType(stateType)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("team %s has no %s state", target.Name, stateType)
+	}
+	for i, state := range candidates {
+		if strings.EqualFold(state.Name, name) {
+			return &candidates[i], nil
+		}
+	}
+	return &candidates[0], nil
+}
+
+// transferLabel returns the label to use in the target team: the same
+// label if it is a workspace label or already available there, else one
+// with the same name.
+func transferLabel(target *linear.TeamDetails, label linear.LabelNode) *linear.LabelNode {
+	for i, candidate := range target.Labels {
+		if candidate.ID == label.ID {
+			return &target.Labels[i]
+		}
+	}
+	if match, err := target.FindLabel(label.Name); err == nil {
+		return match
+	}
+	return nil
+}
+
+// printTransferPlan prints the planned changes followed by what will be
+// lost or created.
+func printTransferPlan(plans []*transferPlan, target *linear.TeamDetails, createLabels bool) {
+	results := make([]*bulkResult, len(plans))
+	for i, plan := range plans {
+		results[i] = plan.result
+	}
+	printBulkPlan(results)
+
+	for _, plan := range plans {
+		for _, warning := range plan.warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+		}
+	}
+	if createLabels {
+		for _, label := range uniqueLabels(plans) {
+			fmt.Fprintf(os.Stderr, "Label '%s' will be created in %s.\n", label.Name, target.Name)
+		}
+	}
+}
+
+// uniqueLabels returns the missing labels of all plans, one per name.
+func uniqueLabels(plans []*transferPlan) []linear.LabelNode {
+	var labels []linear.LabelNode
+	seen := map[string]bool{}
+	for _, plan := range plans {
+		for _, label := range plan.missing {
+			if key := strings.ToLower(label.Name); !seen[key] {
+				seen[key] = true
+				labels = append(labels, label)
+			}
+		}
+	}
+	return labels
+}
+
+// createMissingLabels creates the labels the target team lacks, with their
+// original colors, journals them and adds them to its details.
+func createMissingLabels(apiKey string, plans []*transferPlan, target *linear.TeamDetails) (int, error) {
+	labels := uniqueLabels(plans)
+	for _, label := range labels {
+		created, err := createLabel(apiKey, linear.LabelCreateInput{
+			Name:   label.Name,
+			Color:  label.Color,
+			TeamID: target.ID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("creating label '%s': %w", label.Name, err)
+		}
+		target.Labels = append(target.Labels, *created)
+		fmt.Fprintf(os.Stderr, "Created label '%s' in %s.\n", created.Name, target.Name)
+	}
+	return len(labels), nil
+}
+
+func init() {
+	transferCmd.Flags().String("to-team", "", "Team to move the issues to (name, key or ID)")
+	transferCmd.Flags().String("filter", "", "Filter expression selecting the issues")
+	transferCmd.Flags().String("missing-labels", "drop", "Labels the target team lacks: drop or create")
+	transferCmd.Flags().IntP("limit", "l", 0, "Transfer at most this many matching issues (0 for all)")
+	transferCmd.Flags().Bool("dry-run", false, "Only print the planned changes")
+	transferCmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	transferCmd.Flags().IntP("concurrency", "c", 4, "Maximum number of issues transferred in parallel")
+}
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// transition moves issues to the team's state for a step of the workflow.
+type transition struct {
+	name  string
+	short string
+	// stateTypes are tried in order; the first state by workflow position
+	// of the first type the team has is the target.
+	stateTypes []string
+	// nameHint, if set, must appear in the name of the target state.
+	nameHint string
+}
+
+// setting is the config key that overrides the target state, e.g.
+// START_STATE or START_STATE_ENG.
+func (t transition) setting() string {
+	return strings.ToUpper(t.name) + "_STATE"
+}
+
+var transitions = []transition{
+	{name: "start", short: "Move issues to the team's started state", stateTypes: []string{"started"}},
+	{name: "review", short: "Move issues to the team's review state", stateTypes: []string{"started"}, nameHint: "review"},
+	{name: "done", short: "Move issues to the team's completed state", stateTypes: []string{"completed"}},
+	{name: "cancel", short: "Move issues to the team's canceled state", stateTypes: []string{"canceled"}},
+	{name: "reopen", short: "Move issues back to the team's unstarted state", stateTypes: []string{"unstarted", "backlog"}},
+}
+
+// target picks the state a transition moves an issue of the given team to.
+func (t transition) target(states linear.WorkflowStates, teamKey string) (*linear.StateNode, error) {
+	if name := config.TeamSetting(t.setting(), teamKey); name != "" {
+		state, ok := states.Find(name)
+		if !ok {
+			return nil, fmt.Errorf("state %q configured for %s not found in team %s", name, t.name, teamKey)
+		}
+		return state, nil
+	}
+
+	for _, stateType := range t.stateTypes {
+		for _, state := range states.OfType(stateType) {
+			if t.nameHint == "" || strings.Contains(strings.ToLower(state.Name), t.nameHint) {
+				return &state, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("team %s has no %s state; set %s_%s to choose one", teamKey, t.name, t.setting(), teamKey)
+}
+
+// newTransitionCmd builds the command for a transition.
+func newTransitionCmd(t transition) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   t.name + " <issue>...",
+		Short: t.short,
+		Long: fmt.Sprintf(`%s.
+
+The target is chosen by state type, so renamed workflow states work. When a
+team has several candidate states, the first in workflow order is used; set
+%s (for all teams) or %s_<TEAM-KEY> in your .env to pick one.`,
+			t.short, t.setting(), t.setting()),
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runTransition(cmd, args, func(states linear.WorkflowStates, issue *linear.IssueNode) (*linear.StateNode, error) {
+				return t.target(states, issue.Team.Key)
+			})
+		},
+	}
+	if t.name == "start" {
+		cmd.Flags().BoolP("assign", "a", false, "Assign the issues to yourself")
+		cmd.Flags().Bool("cycle", false, "Add the issues to the team's current cycle")
+	}
+	return cmd
+}
+
+// moveCmd represents the issues move command
+var moveCmd = &cobra.Command{
+	Use:   "move <issue>... --to <state>",
+	Short: "Move issues to a workflow state by name",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		to, _ := cmd.Flags().GetString("to")
+		if to == "" {
+			fmt.Fprintln(os.Stderr, "Error: --to is required.")
+			os.Exit(1)
+		}
+		apiKey := config.GetAPIKey()
+		runTransition(cmd, args, func(states linear.WorkflowStates, issue *linear.IssueNode) (*linear.StateNode, error) {
+			if state, ok := states.Find(to); ok {
+				return state, nil
+			}
+			// The cached states may predate a new state; refetch once.
+			return findWorkflowState(apiKey, issue.Team.ID, to)
+		})
+	},
+}
+
+// runTransition moves every referenced issue to the state chosen by target.
+// Failures are reported per issue and make the command exit non-zero.
+func runTransition(
+	cmd *cobra.Command,
+	refs []string,
+	target func(linear.WorkflowStates, *linear.IssueNode) (*linear.StateNode, error),
+) {
+	assign, _ := cmd.Flags().GetBool("assign")
+	addToCycle, _ := cmd.Flags().GetBool("cycle")
+
+	apiKey := config.GetAPIKey()
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+		os.Exit(1)
+	}
+
+	resolver := newFieldResolver(apiKey)
+	failed := false
+	for _, ref := range refs {
+		if err := transitionIssue(resolver, ref, target, assign, addToCycle); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", ref, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func transitionIssue(
+	r *fieldResolver,
+	ref string,
+	target func(linear.WorkflowStates, *linear.IssueNode) (*linear.StateNode, error),
+	assign, addToCycle bool,
+) error {
+	issue, err := linear.ResolveIssue(r.apiKey, ref, config.GetDefaultTeam())
+	if err != nil {
+		return err
+	}
+	states, err := teamWorkflowStates(r.apiKey, issue.Team.ID, false)
+	if err != nil {
+		return err
+	}
+	state, err := target(states, issue)
+	if err != nil {
+		return err
+	}
+
+	// Plain transitions only need the workflow states; the full team
+	// details are only needed to add the issue to the current cycle.
+	details := &linear.TeamDetails{ID: issue.Team.ID, Key: issue.Team.Key, Name: issue.Team.Name, States: states}
+	if addToCycle {
+		if details, err = r.teamDetails(issue.Team.ID); err != nil {
+			return err
+		}
+	}
+
+	update := newIssueUpdate(issue, details)
+	update.setStateID(state.ID)
+	if assign {
+		viewer, err := r.member(details, "@me")
+		if err != nil {
+			return err
+		}
+		update.setAssignee(viewer.ID, viewer.Name)
+	}
+	if addToCycle {
+		if details.ActiveCycle == nil {
+			return fmt.Errorf("team %s has no active cycle", issue.Team.Key)
+		}
+		update.setCycleID(details.ActiveCycle.ID)
+	}
+
+	if update.empty() {
+		fmt.Printf("%s is already in %s\n", issue.Identifier, state.Name)
+		return nil
+	}
+	if _, err := saveIssueUpdate(r.apiKey, update, stdinIsTerminal()); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", issue.Identifier, strings.Join(update.summary(), ", "))
+	return nil
+}
+
+func init() {
+	moveCmd.Flags().String("to", "", "Name of the target workflow state")
+}
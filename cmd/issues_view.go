@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// viewCmd represents the issues view command
+var viewCmd = &cobra.Command{
+	Use:   "view <issue>",
+	Short: "Show an issue",
+	Long: `Shows all fields of an issue, including its relations to other issues,
+followed by its latest comments (--comments sets how many, 0 for none).`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+		commentCount, _ := cmd.Flags().GetInt("comments")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		issue, err := linear.ResolveIssueFields(apiKey, args[0], config.GetDefaultTeam(), linear.IssueFields+linear.IssueRelationFields+linear.IssueReactionFields)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issue: %v\n", err)
+			os.Exit(1)
+		}
+
+		if format == "json" {
+			printJSON(issue)
+			return
+		}
+		printIssue(*issue)
+
+		if commentCount <= 0 {
+			return
+		}
+		comments, err := linear.FetchComments(apiKey, issue.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching comments: %v\n", err)
+			os.Exit(1)
+		}
+		if len(comments) == 0 {
+			return
+		}
+		shown := comments[max(0, len(comments)-commentCount):]
+		if len(shown) < len(comments) {
+			fmt.Printf("  Comments (last %d of %d):\n", len(shown), len(comments))
+		} else {
+			fmt.Printf("  Comments (%d):\n", len(comments))
+		}
+		printCommentThreads(shown, "    ", time.Now())
+	},
+}
+
+func init() {
+	viewCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+	viewCmd.Flags().Int("comments", 3, "Number of latest comments to show")
+}
@@ -0,0 +1,836 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/emoji"
+	"github.com/Matthew-K310/linear-cli/internal/journal"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+	"github.com/spf13/cobra"
+)
+
+// appendJournal records a mutation. A journal that cannot be written only
+// warns, as the mutation itself already succeeded.
+func appendJournal(entry journal.Entry) {
+	if err := journal.Append(entry); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not write to the journal: %v\n", err)
+	}
+}
+
+// recordUpdate journals a successful issue update.
+func recordUpdate(issue *linear.IssueNode, changes []fieldChange) {
+	entry := journal.Entry{
+		Action:     journal.ActionUpdate,
+		IssueID:    issue.ID,
+		Identifier: issue.Identifier,
+		UpdatedAt:  issue.UpdatedAt,
+	}
+	for _, change := range changes {
+		entry.Changes = append(entry.Changes, journal.Change(change))
+	}
+	appendJournal(entry)
+}
+
+// createIssue creates an issue and journals it.
+func createIssue(apiKey string, input linear.IssueCreateInput) (*linear.IssueNode, error) {
+	issue, err := linear.CreateIssue(apiKey, input)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionCreate,
+		IssueID:    issue.ID,
+		Identifier: issue.Identifier,
+		UpdatedAt:  issue.UpdatedAt,
+	})
+	return issue, nil
+}
+
+// createRelation records issue <relationType> related and journals it.
+func createRelation(apiKey string, issue, related *linear.IssueNode, relationType string) (*linear.IssueRelationNode, error) {
+	relation, err := linear.CreateIssueRelation(apiKey, issue.ID, related.ID, relationType)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:            journal.ActionRelate,
+		IssueID:           issue.ID,
+		Identifier:        issue.Identifier,
+		TargetID:          relation.ID,
+		RelatedIssueID:    related.ID,
+		RelatedIdentifier: related.Identifier,
+		RelationType:      relationType,
+	})
+	return relation, nil
+}
+
+// deleteRelation removes a relation seen from issue and journals it.
+func deleteRelation(apiKey string, issue *linear.IssueNode, relation linear.Relation) error {
+	if err := linear.DeleteIssueRelation(apiKey, relation.ID); err != nil {
+		return err
+	}
+	// Journal the relation in its API direction so undo can recreate it.
+	entry := journal.Entry{
+		Action:            journal.ActionUnrelate,
+		IssueID:           issue.ID,
+		Identifier:        issue.Identifier,
+		TargetID:          relation.ID,
+		RelatedIssueID:    relation.Issue.ID,
+		RelatedIdentifier: relation.Issue.Identifier,
+		RelationType:      relation.Type,
+	}
+	if relation.Inverse {
+		entry.IssueID, entry.RelatedIssueID = entry.RelatedIssueID, entry.IssueID
+		entry.Identifier, entry.RelatedIdentifier = entry.RelatedIdentifier, entry.Identifier
+	}
+	appendJournal(entry)
+	return nil
+}
+
+// createComment comments on an issue, or replies to a comment, and
+// journals it.
+func createComment(apiKey string, issue *linear.IssueNode, parentID, body string) (*linear.CommentNode, error) {
+	comment, err := linear.CreateComment(apiKey, linear.CommentCreateInput{
+		IssueID:  issue.ID,
+		ParentID: parentID,
+		Body:     body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionComment,
+		IssueID:    issue.ID,
+		Identifier: issue.Identifier,
+		TargetID:   comment.ID,
+	})
+	return comment, nil
+}
+
+// updateComment replaces a comment's body and journals the previous one.
+func updateComment(apiKey string, comment *linear.CommentNode, body string) (*linear.CommentNode, error) {
+	updated, err := linear.UpdateComment(apiKey, comment.ID, body)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionCommentEdit,
+		IssueID:    comment.Issue.ID,
+		Identifier: comment.Issue.Identifier,
+		TargetID:   comment.ID,
+		Changes:    []journal.Change{commentBodyChange(comment.Body, body)},
+	})
+	return updated, nil
+}
+
+// deleteComment deletes a comment, journaling its body so that undo can
+// post it again.
+func deleteComment(apiKey string, comment *linear.CommentNode) error {
+	if err := linear.DeleteComment(apiKey, comment.ID); err != nil {
+		return err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionCommentDelete,
+		IssueID:    comment.Issue.ID,
+		Identifier: comment.Issue.Identifier,
+		TargetID:   comment.ID,
+		ParentID:   comment.ParentID(),
+		Changes:    []journal.Change{commentBodyChange(comment.Body, "")},
+	})
+	return nil
+}
+
+// createReaction adds a reaction to the issue or comment of input and
+// journals it. issue is the issue reacted to, or the comment's issue.
+func createReaction(apiKey string, input linear.ReactionCreateInput, issue linear.IssueRef) (*linear.ReactionNode, error) {
+	reaction, err := linear.CreateReaction(apiKey, input)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(reactionEntry(journal.ActionReact, reaction.ID, input, issue))
+	return reaction, nil
+}
+
+// deleteReaction removes a reaction with the emoji of input from its issue
+// or comment and journals it.
+func deleteReaction(apiKey, id string, input linear.ReactionCreateInput, issue linear.IssueRef) error {
+	if err := linear.DeleteReaction(apiKey, id); err != nil {
+		return err
+	}
+	appendJournal(reactionEntry(journal.ActionUnreact, id, input, issue))
+	return nil
+}
+
+func reactionEntry(action, id string, input linear.ReactionCreateInput, issue linear.IssueRef) journal.Entry {
+	change := journal.Change{Field: "reaction", Key: "emoji", Before: input.Emoji, From: emoji.Unicode(input.Emoji), To: "none"}
+	if action == journal.ActionReact {
+		change = journal.Change{Field: "reaction", Key: "emoji", After: input.Emoji, From: "none", To: emoji.Unicode(input.Emoji)}
+	}
+	return journal.Entry{
+		Action:     action,
+		IssueID:    issue.ID,
+		Identifier: issue.Identifier,
+		TargetID:   id,
+		CommentID:  input.CommentID,
+		Changes:    []journal.Change{change},
+	}
+}
+
+// createLabel creates an issue label and journals it.
+func createLabel(apiKey string, input linear.LabelCreateInput) (*linear.LabelNode, error) {
+	label, err := linear.CreateLabel(apiKey, input)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionLabelCreate,
+		TargetID:   label.ID,
+		TargetName: label.Path(),
+	})
+	return label, nil
+}
+
+// renameLabel renames a label and journals its previous name.
+func renameLabel(apiKey string, label *linear.LabelNode, name string) (*linear.LabelNode, error) {
+	renamed, err := linear.RenameLabel(apiKey, label.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionLabelRename,
+		TargetID:   label.ID,
+		TargetName: renamed.Path(),
+		Changes: []journal.Change{{
+			Field:  "name",
+			Key:    "name",
+			Before: label.Name,
+			After:  name,
+			From:   strconv.Quote(label.Name),
+			To:     strconv.Quote(name),
+		}},
+	})
+	return renamed, nil
+}
+
+// deleteLabel deletes a label, journaling its fields so that undo can
+// create it again.
+func deleteLabel(apiKey string, label *linear.LabelNode) error {
+	if err := linear.DeleteLabel(apiKey, label.ID); err != nil {
+		return err
+	}
+	entry := journal.Entry{
+		Action:     journal.ActionLabelDelete,
+		TargetID:   label.ID,
+		TargetName: label.Path(),
+		Changes: []journal.Change{
+			{Field: "name", Key: "name", Before: label.Name, From: strconv.Quote(label.Name), To: "none"},
+			{Field: "color", Key: "color", Before: label.Color, From: label.Color, To: "none"},
+		},
+	}
+	if label.IsGroup {
+		entry.Changes = append(entry.Changes, journal.Change{Field: "group", Key: "isGroup", Before: true, From: "yes", To: "none"})
+	}
+	if label.Parent != nil {
+		entry.Changes = append(entry.Changes, journal.Change{Field: "group", Key: "parentId", Before: label.Parent.ID, From: label.Parent.Name, To: "none"})
+	}
+	if label.Team != nil {
+		entry.Changes = append(entry.Changes, journal.Change{Field: "team", Key: "teamId", Before: label.Team.ID, From: label.Team.Name, To: "none"})
+	}
+	appendJournal(entry)
+	return nil
+}
+
+// createProject creates a project and journals it.
+func createProject(apiKey string, input linear.ProjectCreateInput) (*linear.ProjectNode, error) {
+	project, err := linear.CreateProject(apiKey, input)
+	if err != nil {
+		return nil, err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionProjectCreate,
+		TargetID:   project.ID,
+		TargetName: project.Name,
+	})
+	return project, nil
+}
+
+// updateProject applies a project update and journals its changes.
+func updateProject(apiKey string, update *projectUpdate) (*linear.ProjectNode, error) {
+	updated, err := linear.UpdateProject(apiKey, update.project.ID, update.input)
+	if err != nil {
+		return nil, err
+	}
+	entry := journal.Entry{
+		Action:     journal.ActionProjectUpdate,
+		TargetID:   updated.ID,
+		TargetName: updated.Name,
+	}
+	for _, change := range update.changes {
+		entry.Changes = append(entry.Changes, journal.Change(change))
+	}
+	appendJournal(entry)
+	return updated, nil
+}
+
+// archiveProject archives a project and journals it.
+func archiveProject(apiKey string, project *linear.ProjectNode) error {
+	if err := linear.ArchiveProject(apiKey, project.ID); err != nil {
+		return err
+	}
+	appendJournal(journal.Entry{
+		Action:     journal.ActionProjectArchive,
+		TargetID:   project.ID,
+		TargetName: project.Name,
+	})
+	return nil
+}
+
+func commentBodyChange(before, after string) journal.Change {
+	return journal.Change{
+		Field:  "comment",
+		Key:    "body",
+		Before: before,
+		After:  after,
+		From:   describeText(before),
+		To:     describeText(after),
+	}
+}
+
+// describeEntry renders what a journal entry did in one line.
+func describeEntry(entry journal.Entry) string {
+	switch entry.Action {
+	case journal.ActionCreate:
+		return "created " + entry.Identifier
+	case journal.ActionComment:
+		return "commented on " + entry.Identifier
+	case journal.ActionCommentEdit:
+		return "edited a comment on " + entry.Identifier
+	case journal.ActionCommentDelete:
+		return "deleted a comment on " + entry.Identifier
+	case journal.ActionArchive, journal.ActionUnarchive, journal.ActionDelete, journal.ActionRestore:
+		return entry.Action + " " + entry.Identifier
+	case journal.ActionReact, journal.ActionUnreact:
+		target := entry.Identifier
+		if entry.CommentID != "" {
+			target = "a comment on " + entry.Identifier
+		}
+		if entry.Action == journal.ActionReact {
+			return fmt.Sprintf("reacted %s to %s", emoji.Unicode(reactionEmoji(entry)), target)
+		}
+		return fmt.Sprintf("removed %s from %s", emoji.Unicode(reactionEmoji(entry)), target)
+	case journal.ActionLabelCreate:
+		return fmt.Sprintf("created label '%s'", entry.TargetName)
+	case journal.ActionLabelDelete:
+		return fmt.Sprintf("deleted label '%s'", entry.TargetName)
+	case journal.ActionProjectCreate:
+		return fmt.Sprintf("created project '%s'", entry.TargetName)
+	case journal.ActionProjectArchive:
+		return fmt.Sprintf("archived project '%s'", entry.TargetName)
+	case journal.ActionLabelRename:
+		if len(entry.Changes) > 0 {
+			return fmt.Sprintf("renamed label %s to %s", entry.Changes[0].From, entry.Changes[0].To)
+		}
+	case journal.ActionRelate, journal.ActionUnrelate:
+		return fmt.Sprintf("%s %s %s %s", entry.Action, entry.Identifier, entry.RelationType, entry.RelatedIdentifier)
+	}
+	// Entries about labels and projects have no issue.
+	subject := entry.Identifier
+	if subject == "" {
+		subject = entry.TargetName
+	}
+	if entry.Action == journal.ActionUndo && len(entry.Changes) == 0 {
+		return "undo on " + subject
+	}
+
+	changes := make([]string, len(entry.Changes))
+	for i, change := range entry.Changes {
+		changes[i] = fmt.Sprintf("%s: %s -> %s", change.Field, change.From, change.To)
+	}
+	description := subject + " " + strings.Join(changes, "; ")
+	if entry.Action == journal.ActionUndo {
+		description = "undo " + description
+	}
+	return description
+}
+
+var journalHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Browse the journal of changes made with this CLI",
+	Long: `Lists the mutations performed by linear-cli, newest first, with the
+before and after value of every changed field. The journal is kept in
+~/.config/linear_cli/journal.jsonl and is separate per profile
+(LINEAR_PROFILE). Use 'linear-cli undo' to revert entries.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, _ := cmd.Flags().GetInt("limit")
+		allProfiles, _ := cmd.Flags().GetBool("all-profiles")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		profile := config.Profile()
+		if allProfiles {
+			profile = ""
+		}
+		entries, err := journal.Read(profile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading journal: %v\n", err)
+			os.Exit(1)
+		}
+
+		undone := map[string]bool{}
+		for _, entry := range entries {
+			if entry.Action == journal.ActionUndo {
+				undone[entry.Undoes] = true
+			}
+		}
+
+		slices.Reverse(entries)
+		if limit > 0 && len(entries) > limit {
+			entries = entries[:limit]
+		}
+
+		if format == "json" {
+			printJSON(entries)
+			return
+		}
+		if len(entries) == 0 {
+			fmt.Println("The journal is empty.")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TIME\tPROFILE\tCHANGE\t")
+		for _, entry := range entries {
+			description := describeEntry(entry)
+			if undone[entry.ID] {
+				description += " (undone)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t\n",
+				entry.Time.Local().Format("2006-01-02 15:04"), entry.Profile, description)
+		}
+		w.Flush()
+	},
+}
+
+var undoCmd = &cobra.Command{
+	Use:   "undo [n]",
+	Short: "Revert the last n changes made with this CLI",
+	Long: `Reverts the last n journaled changes of the current profile (default 1),
+newest first. Updates are reverted to their previous field values, created
+issues are moved to the trash, new comments are deleted and edited ones get
+their previous text back, deleted comments are posted again, relations are
+removed or recreated, archived or trashed issues are restored (and vice
+versa), reactions are removed or added again, created labels are deleted,
+renamed labels get their old name back and deleted labels are created again;
+undoing a label merge also puts the label back on the merged issues.
+Created projects are archived, updated ones get their previous values back
+and archived ones are unarchived.
+
+If an issue was changed by someone else since, you are asked whether to
+revert anyway; without a terminal the entry is refused unless --force is
+given. Changes to fields the entry did not touch do not count.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		yes, _ := cmd.Flags().GetBool("yes")
+		force, _ := cmd.Flags().GetBool("force")
+		interactive := stdinIsTerminal()
+
+		n := 1
+		if len(args) == 1 {
+			var err error
+			n, err = strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				fmt.Fprintf(os.Stderr, "Error: invalid count '%s'\n", args[0])
+				os.Exit(1)
+			}
+		}
+
+		entries, err := journal.Read(config.Profile())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading journal: %v\n", err)
+			os.Exit(1)
+		}
+		undoable := journal.Undoable(entries)
+		if len(undoable) == 0 {
+			fmt.Println("Nothing to undo.")
+			return
+		}
+		if n > len(undoable) {
+			fmt.Fprintf(os.Stderr, "Only %d change(s) can be undone.\n", len(undoable))
+			n = len(undoable)
+		}
+		undoable = undoable[:n]
+
+		fmt.Fprintln(os.Stderr, "Changes to undo:")
+		for _, entry := range undoable {
+			fmt.Fprintf(os.Stderr, "  %s  %s\n", entry.Time.Local().Format("2006-01-02 15:04"), describeEntry(entry))
+		}
+		if interactive && !yes && !confirm("Undo these changes") {
+			fmt.Fprintln(os.Stderr, "Aborted.")
+			return
+		}
+
+		// The updatedAt each issue had after the newest journaled change,
+		// kept current as entries are undone.
+		known := map[string]time.Time{}
+		for _, entry := range entries {
+			if !entry.UpdatedAt.IsZero() {
+				known[entry.IssueID] = entry.UpdatedAt
+			}
+		}
+
+		// Labels whose deletion was undone were created again with a new ID.
+		labelIDs := map[string]string{}
+		deleted := map[string]string{}
+		for _, entry := range entries {
+			switch {
+			case entry.Action == journal.ActionLabelDelete:
+				deleted[entry.ID] = entry.TargetID
+			case entry.Action == journal.ActionUndo && deleted[entry.Undoes] != "":
+				labelIDs[deleted[entry.Undoes]] = entry.TargetID
+			}
+		}
+
+		undo := &undoer{apiKey: apiKey, known: known, force: force, interactive: interactive, labelIDs: labelIDs}
+		failed := 0
+		for _, entry := range undoable {
+			if err := undo.revert(entry); err != nil {
+				fmt.Fprintf(os.Stderr, "Error undoing %s: %v\n", describeEntry(entry), err)
+				failed++
+				continue
+			}
+			fmt.Printf("Undid %s\n", describeEntry(entry))
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// undoer reverts journal entries.
+type undoer struct {
+	apiKey      string
+	known       map[string]time.Time
+	force       bool
+	interactive bool
+	// labelIDs maps the IDs of deleted labels to those of the labels undo
+	// created in their place.
+	labelIDs map[string]string
+}
+
+// labelID returns the ID a label has now, after any undone deletion.
+func (u *undoer) labelID(id string) string {
+	for {
+		replacement, ok := u.labelIDs[id]
+		if !ok {
+			return id
+		}
+		id = replacement
+	}
+}
+
+// revert undoes one entry and journals the undo.
+func (u *undoer) revert(entry journal.Entry) error {
+	record := journal.Entry{
+		Action:     journal.ActionUndo,
+		IssueID:    entry.IssueID,
+		Identifier: entry.Identifier,
+		TargetName: entry.TargetName,
+		Undoes:     entry.ID,
+	}
+
+	switch entry.Action {
+	case journal.ActionComment:
+		if err := linear.DeleteComment(u.apiKey, entry.TargetID); err != nil {
+			return err
+		}
+
+	case journal.ActionCommentEdit:
+		if _, err := linear.UpdateComment(u.apiKey, entry.TargetID, commentBefore(entry)); err != nil {
+			return err
+		}
+
+	case journal.ActionCommentDelete:
+		// Deleted comments cannot be restored; post the body again.
+		comment, err := linear.CreateComment(u.apiKey, linear.CommentCreateInput{
+			IssueID:  entry.IssueID,
+			ParentID: entry.ParentID,
+			Body:     commentBefore(entry),
+		})
+		if err != nil {
+			return err
+		}
+		record.TargetID = comment.ID
+
+	case journal.ActionRelate:
+		if err := linear.DeleteIssueRelation(u.apiKey, entry.TargetID); err != nil {
+			return err
+		}
+
+	case journal.ActionUnrelate:
+		if _, err := linear.CreateIssueRelation(u.apiKey, entry.IssueID, entry.RelatedIssueID, entry.RelationType); err != nil {
+			return err
+		}
+
+	case journal.ActionArchive, journal.ActionDelete:
+		if err := linear.UnarchiveIssue(u.apiKey, entry.IssueID); err != nil {
+			return err
+		}
+
+	case journal.ActionUnarchive:
+		if err := linear.ArchiveIssue(u.apiKey, entry.IssueID); err != nil {
+			return err
+		}
+
+	case journal.ActionRestore:
+		if err := linear.DeleteIssue(u.apiKey, entry.IssueID); err != nil {
+			return err
+		}
+
+	case journal.ActionReact:
+		if err := linear.DeleteReaction(u.apiKey, entry.TargetID); err != nil {
+			return err
+		}
+
+	case journal.ActionUnreact:
+		input := linear.ReactionCreateInput{Emoji: reactionEmoji(entry), CommentID: entry.CommentID}
+		if entry.CommentID == "" {
+			input.IssueID = entry.IssueID
+		}
+		reaction, err := linear.CreateReaction(u.apiKey, input)
+		if err != nil {
+			return err
+		}
+		record.TargetID = reaction.ID
+
+	case journal.ActionLabelCreate:
+		if err := linear.DeleteLabel(u.apiKey, u.labelID(entry.TargetID)); err != nil {
+			return err
+		}
+
+	case journal.ActionLabelRename:
+		var input map[string]any
+		input, record.Changes = reverseChanges(entry.Changes)
+		name, _ := input["name"].(string)
+		if _, err := linear.RenameLabel(u.apiKey, u.labelID(entry.TargetID), name); err != nil {
+			return err
+		}
+
+	case journal.ActionLabelDelete:
+		// Deleted labels cannot be restored; create the label again and
+		// use its new ID when undoing older entries that refer to it.
+		input := linear.LabelCreateInput{}
+		for _, change := range entry.Changes {
+			value, _ := change.Before.(string)
+			switch change.Key {
+			case "name":
+				input.Name = value
+			case "color":
+				input.Color = value
+			case "teamId":
+				input.TeamID = value
+			case "parentId":
+				input.ParentID = u.labelID(value)
+			case "isGroup":
+				input.IsGroup = change.Before == true
+			}
+		}
+		label, err := linear.CreateLabel(u.apiKey, input)
+		if err != nil {
+			return err
+		}
+		record.TargetID = label.ID
+		u.labelIDs[entry.TargetID] = label.ID
+
+	case journal.ActionProjectCreate:
+		if err := linear.ArchiveProject(u.apiKey, entry.TargetID); err != nil {
+			return err
+		}
+
+	case journal.ActionProjectUpdate:
+		var input map[string]any
+		input, record.Changes = reverseChanges(entry.Changes)
+		if _, err := linear.UpdateProject(u.apiKey, entry.TargetID, input); err != nil {
+			return err
+		}
+
+	case journal.ActionProjectArchive:
+		if err := linear.UnarchiveProject(u.apiKey, entry.TargetID); err != nil {
+			return err
+		}
+
+	case journal.ActionCreate:
+		latest, err := linear.ResolveIssue(u.apiKey, entry.IssueID, "")
+		if err != nil {
+			return err
+		}
+		if u.changedSince(latest) {
+			if err := u.confirmChanged(entry, "it was changed since it was created"); err != nil {
+				return err
+			}
+		}
+		if err := linear.DeleteIssue(u.apiKey, entry.IssueID); err != nil {
+			return err
+		}
+
+	case journal.ActionUpdate:
+		latest, err := linear.ResolveIssue(u.apiKey, entry.IssueID, "")
+		if err != nil {
+			return err
+		}
+		if u.changedSince(latest) {
+			if changed := changedFields(latest, entry.Changes); len(changed) > 0 {
+				reason := "someone else changed " + strings.Join(changed, ", ") + " since"
+				if err := u.confirmChanged(entry, reason); err != nil {
+					return err
+				}
+			}
+		}
+
+		var input map[string]any
+		input, record.Changes = reverseChanges(entry.Changes)
+		if ids, ok := input["labelIds"].([]any); ok {
+			for i, id := range ids {
+				if id, ok := id.(string); ok {
+					ids[i] = u.labelID(id)
+				}
+			}
+		}
+		updated, err := linear.UpdateIssue(u.apiKey, entry.IssueID, input)
+		if err != nil {
+			return err
+		}
+		record.UpdatedAt = updated.UpdatedAt
+		u.known[entry.IssueID] = updated.UpdatedAt
+
+	default:
+		return fmt.Errorf("cannot undo '%s' entries", entry.Action)
+	}
+
+	appendJournal(record)
+	return nil
+}
+
+// reverseChanges returns the input restoring the values changes replaced,
+// and the changes that input makes.
+func reverseChanges(changes []journal.Change) (map[string]any, []journal.Change) {
+	input := map[string]any{}
+	reversed := make([]journal.Change, len(changes))
+	for i, change := range changes {
+		input[change.Key] = change.Before
+		reversed[i] = journal.Change{
+			Field:  change.Field,
+			Key:    change.Key,
+			Before: change.After,
+			After:  change.Before,
+			From:   change.To,
+			To:     change.From,
+		}
+	}
+	return input, reversed
+}
+
+// changedSince reports whether the issue was updated after the newest
+// change this CLI made to it.
+func (u *undoer) changedSince(latest *linear.IssueNode) bool {
+	known, ok := u.known[latest.ID]
+	return !ok || !latest.UpdatedAt.Equal(known)
+}
+
+// confirmChanged asks whether to undo an entry whose issue changed, or
+// refuses without a terminal unless --force was given.
+func (u *undoer) confirmChanged(entry journal.Entry, reason string) error {
+	if u.force {
+		return nil
+	}
+	if !u.interactive {
+		return fmt.Errorf("%s (use --force to undo anyway)", reason)
+	}
+	fmt.Fprintf(os.Stderr, "%s: %s.\n", entry.Identifier, reason)
+	if !confirm("Undo anyway") {
+		return fmt.Errorf("skipped")
+	}
+	return nil
+}
+
+// commentBefore returns the comment body journaled by a comment-edit or
+// comment-delete entry.
+func commentBefore(entry journal.Entry) string {
+	for _, change := range entry.Changes {
+		if change.Key == "body" {
+			body, _ := change.Before.(string)
+			return body
+		}
+	}
+	return ""
+}
+
+// reactionEmoji returns the emoji name journaled by a react or unreact
+// entry.
+func reactionEmoji(entry journal.Entry) string {
+	for _, change := range entry.Changes {
+		if change.Key == "emoji" {
+			if name, ok := change.After.(string); ok {
+				return name
+			}
+			name, _ := change.Before.(string)
+			return name
+		}
+	}
+	return ""
+}
+
+// changedFields returns the fields of changes that no longer hold the value
+// the change set.
+func changedFields(latest *linear.IssueNode, changes []journal.Change) []string {
+	current := newIssueUpdate(latest, nil)
+	var changed []string
+	for _, change := range changes {
+		if !sameValue(current.currentValue(change.Key), change.After) {
+			changed = append(changed, change.Field)
+		}
+	}
+	return changed
+}
+
+// sameValue compares API values by their JSON form, so that values read
+// back from the journal compare equal to freshly fetched ones. Lists are
+// compared regardless of order.
+func sameValue(a, b any) bool {
+	normalize := func(value any) string {
+		data, _ := json.Marshal(value)
+		var list []string
+		if json.Unmarshal(data, &list) == nil && list != nil {
+			slices.Sort(list)
+			data, _ = json.Marshal(list)
+		}
+		return string(data)
+	}
+	return normalize(a) == normalize(b)
+}
+
+func init() {
+	rootCmd.AddCommand(journalHistoryCmd)
+	rootCmd.AddCommand(undoCmd)
+
+	journalHistoryCmd.Flags().IntP("limit", "n", 20, "Number of entries to show (0 for all)")
+	journalHistoryCmd.Flags().Bool("all-profiles", false, "Show entries of every profile")
+	journalHistoryCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+
+	undoCmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	undoCmd.Flags().Bool("force", false, "Undo even if the issue was changed since")
+}
@@ -0,0 +1,427 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+var labelColorPattern = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+
+var labelsRootCmd = &cobra.Command{
+	Use:   "labels",
+	Short: "Manage issue labels",
+	Long: `Lists, creates, renames, merges and deletes issue labels. Labels are
+given by name, ignoring case, or as "Group/Name" for labels in a group. Team
+labels take precedence over workspace labels of the same name; use --team
+when a name exists in several teams.`,
+}
+
+var labelsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List labels with the number of issues using them",
+	Long: `Lists the workspace labels and those of every team, or of --team only.
+With --team, each label also shows how many of the team's issues use it.
+Counting goes through every labeled issue, so for all teams it has to be
+asked for with --counts.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		teamName, _ := cmd.Flags().GetString("team")
+		counts, _ := cmd.Flags().GetBool("counts")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		teamID := optionalTeamID(apiKey, teamName)
+		labels, err := linear.FetchLabels(apiKey, teamID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		var usage map[string]int
+		if counts || teamID != "" {
+			if usage, err = labelUsage(apiKey, labels, teamID); err != nil {
+				fmt.Fprintf(os.Stderr, "Error counting label usage: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		if format == "json" {
+			type jsonLabel struct {
+				linear.LabelNode
+				Issues *int `json:"issues,omitempty"`
+			}
+			out := make([]jsonLabel, len(labels))
+			for i, label := range labels {
+				out[i] = jsonLabel{LabelNode: label}
+				if usage != nil {
+					count := usage[label.ID]
+					out[i].Issues = &count
+				}
+			}
+			printJSON(out)
+			return
+		}
+		if len(labels) == 0 {
+			fmt.Println("No labels found.")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if usage != nil {
+			fmt.Fprintln(w, "NAME\tSCOPE\tCOLOR\tISSUES\t")
+		} else {
+			fmt.Fprintln(w, "NAME\tSCOPE\tCOLOR\t")
+		}
+		for _, label := range labels {
+			name, count := label.Name, fmt.Sprint(usage[label.ID])
+			if label.Parent != nil {
+				name = "  " + name
+			}
+			if label.IsGroup {
+				name += "/"
+				count = ""
+			}
+			if usage != nil {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", name, labelScope(label), label.Color, count)
+			} else {
+				fmt.Fprintf(w, "%s\t%s\t%s\t\n", name, labelScope(label), label.Color)
+			}
+		}
+		w.Flush()
+	},
+}
+
+var labelsCreateCmd = &cobra.Command{
+	Use:   "create --name <name>",
+	Short: "Create a label or label group",
+	Long: `Creates a workspace label, or a team label with --team. --parent puts the
+label into a label group (created with --group); an issue can have only one
+label of each group. A label in a team group is created in that team.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		color, _ := cmd.Flags().GetString("color")
+		parentName, _ := cmd.Flags().GetString("parent")
+		teamName, _ := cmd.Flags().GetString("team")
+		group, _ := cmd.Flags().GetBool("group")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+		name = strings.TrimSpace(name)
+		if name == "" {
+			fmt.Fprintln(os.Stderr, "Error: --name is required.")
+			os.Exit(1)
+		}
+		if color != "" {
+			if !labelColorPattern.MatchString(color) {
+				fmt.Fprintf(os.Stderr, "Error: invalid color '%s' (expected a hex color such as #4ea7fc)\n", color)
+				os.Exit(1)
+			}
+			color = "#" + strings.TrimPrefix(color, "#")
+		}
+
+		input := linear.LabelCreateInput{
+			Name:    name,
+			Color:   color,
+			TeamID:  optionalTeamID(apiKey, teamName),
+			IsGroup: group,
+		}
+		if parentName != "" {
+			labels, err := linear.FetchLabels(apiKey, input.TeamID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			parent, err := labels.Find(parentName, input.TeamID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			if !parent.IsGroup {
+				fmt.Fprintf(os.Stderr, "Error: '%s' is not a label group\n", parent.Name)
+				os.Exit(1)
+			}
+			input.ParentID = parent.ID
+			if parent.Team != nil {
+				input.TeamID = parent.Team.ID
+			}
+		}
+
+		label, err := createLabel(apiKey, input)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating label: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Created label '%s' (%s, %s)\n", label.Path(), labelScope(*label), label.Color)
+	},
+}
+
+var labelsRenameCmd = &cobra.Command{
+	Use:   "rename <label> <new-name>",
+	Short: "Rename a label",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		teamName, _ := cmd.Flags().GetString("team")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		_, label := findLabel(apiKey, optionalTeamID(apiKey, teamName), args[0])
+		renamed, err := renameLabel(apiKey, label, strings.TrimSpace(args[1]))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error renaming label: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Renamed '%s' to '%s'\n", label.Path(), renamed.Path())
+	},
+}
+
+var labelsMergeCmd = &cobra.Command{
+	Use:   "merge <from> <into>",
+	Short: "Move every issue from one label to another and delete the first",
+	Long: `Replaces the label <from> with <into> on every issue that has it, archived
+issues included, then deletes <from>. The affected issues are listed and you
+are asked to confirm unless --yes is given; --dry-run only lists them. If
+any issue fails to update, <from> is kept so the merge can be retried. The
+issue updates and the deletion are journaled; undoing all of them with
+'linear-cli undo <n>' creates <from> again and puts it back on the issues.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		teamName, _ := cmd.Flags().GetString("team")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		teamID := optionalTeamID(apiKey, teamName)
+		labels, from := findLabel(apiKey, teamID, args[0])
+		into, err := labels.Find(args[1], teamID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if from.ID == into.ID {
+			fmt.Fprintln(os.Stderr, "Error: cannot merge a label into itself.")
+			os.Exit(1)
+		}
+		if from.IsGroup || into.IsGroup {
+			fmt.Fprintln(os.Stderr, "Error: label groups cannot be merged.")
+			os.Exit(1)
+		}
+
+		fmt.Fprintln(os.Stderr, "Fetching labeled issues...")
+		issues, err := linear.FetchIssues(apiKey, linear.IssueQuery{
+			Filter:          labelFilter([]string{from.ID}),
+			IncludeArchived: true,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issues: %v\n", err)
+			os.Exit(1)
+		}
+
+		results := make([]*bulkResult, len(issues))
+		for i := range issues {
+			results[i] = planLabelMerge(&issues[i], labels, from, into)
+		}
+		if len(results) > 0 {
+			printBulkPlan(results)
+		}
+		if dryRun {
+			fmt.Fprintf(os.Stderr, "Dry run: nothing was changed; '%s' would then be deleted.\n", from.Path())
+			return
+		}
+		if !yes {
+			if !stdinIsTerminal() {
+				fmt.Fprintln(os.Stderr, "Error: confirmation required; re-run with --yes or --dry-run.")
+				os.Exit(1)
+			}
+			if !confirm(fmt.Sprintf("Merge '%s' into '%s' (%d issues) and delete '%s'", from.Path(), into.Path(), len(issues), from.Path())) {
+				fmt.Fprintln(os.Stderr, "Aborted.")
+				return
+			}
+		}
+
+		applyBulkUpdates(apiKey, results, 4)
+		if failed := summarizeBulkUpdate(results); len(failed) > 0 {
+			fmt.Fprintf(os.Stderr, "Kept '%s' because some issues could not be relabeled.\n", from.Path())
+			os.Exit(1)
+		}
+		if err := deleteLabel(apiKey, from); err != nil {
+			fmt.Fprintf(os.Stderr, "Error deleting label: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Merged '%s' into '%s' and deleted '%s'\n", from.Path(), into.Path(), from.Path())
+	},
+}
+
+var labelsDeleteCmd = &cobra.Command{
+	Use:   "delete <label>",
+	Short: "Delete a label, removing it from every issue",
+	Long: `Deletes a label after asking for confirmation, unless --yes is given. The
+label is removed from every issue. 'linear-cli undo' creates the label again
+with the same name, color and group, but does not put it back on issues.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		teamName, _ := cmd.Flags().GetString("team")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		_, label := findLabel(apiKey, optionalTeamID(apiKey, teamName), args[0])
+		if !yes {
+			if !stdinIsTerminal() {
+				fmt.Fprintln(os.Stderr, "Error: confirmation required; re-run with --yes.")
+				os.Exit(1)
+			}
+			usage, err := labelUsage(apiKey, linear.Labels{*label}, "")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error counting label usage: %v\n", err)
+				os.Exit(1)
+			}
+			if !confirm(fmt.Sprintf("Delete '%s' (used by %d issues)", label.Path(), usage[label.ID])) {
+				fmt.Fprintln(os.Stderr, "Aborted.")
+				return
+			}
+		}
+
+		if err := deleteLabel(apiKey, label); err != nil {
+			fmt.Fprintf(os.Stderr, "Error deleting label: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Deleted label '%s'\n", label.Path())
+	},
+}
+
+// planLabelMerge plans replacing from with into on one issue.
+func planLabelMerge(issue *linear.IssueNode, labels linear.Labels, from, into *linear.LabelNode) *bulkResult {
+	result := &bulkResult{Identifier: issue.Identifier, Title: issue.Title}
+	if into.Team != nil && into.Team.ID != issue.Team.ID {
+		result.Error = fmt.Sprintf("'%s' belongs to team %s", into.Path(), into.Team.Name)
+		return result
+	}
+
+	ids := slices.DeleteFunc(issue.LabelIDs(), func(id string) bool { return id == from.ID })
+	if !slices.Contains(ids, into.ID) {
+		ids = append(ids, into.ID)
+	}
+	details := &linear.TeamDetails{ID: issue.Team.ID, Key: issue.Team.Key, Name: issue.Team.Name, Labels: labels}
+	result.update = newIssueUpdate(issue, details)
+	result.update.setLabelIDs(ids)
+	result.Changes = result.update.changes
+	return result
+}
+
+// labelFilter matches issues with any of the labels.
+func labelFilter(ids []string) map[string]any {
+	return map[string]any{"labels": map[string]any{"some": map[string]any{"id": map[string]any{"in": ids}}}}
+}
+
+// labelUsage counts the issues using each label, within a team if teamID
+// is set.
+func labelUsage(apiKey string, labels linear.Labels, teamID string) (map[string]int, error) {
+	usage := map[string]int{}
+	var ids []string
+	for _, label := range labels {
+		if !label.IsGroup {
+			ids = append(ids, label.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return usage, nil
+	}
+
+	filter := labelFilter(ids)
+	if teamID != "" {
+		filter["team"] = map[string]any{"id": map[string]any{"eq": teamID}}
+	}
+	issues, err := linear.FetchIssues(apiKey, linear.IssueQuery{
+		Filter: filter,
+		Fields: "id labels { nodes { id } }",
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range issues {
+		for _, id := range issue.LabelIDs() {
+			usage[id]++
+		}
+	}
+	return usage, nil
+}
+
+// findLabel fetches the workspace labels and those of team teamID, or of
+// every team, and finds one, exiting on failure.
+func findLabel(apiKey, teamID, name string) (linear.Labels, *linear.LabelNode) {
+	labels, err := linear.FetchLabels(apiKey, teamID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	label, err := labels.Find(name, teamID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	return labels, label
+}
+
+func labelScope(label linear.LabelNode) string {
+	if label.Team == nil {
+		return "workspace"
+	}
+	return label.Team.Name
+}
+
+func init() {
+	rootCmd.AddCommand(labelsRootCmd)
+	labelsRootCmd.AddCommand(labelsListCmd)
+	labelsRootCmd.AddCommand(labelsCreateCmd)
+	labelsRootCmd.AddCommand(labelsRenameCmd)
+	labelsRootCmd.AddCommand(labelsMergeCmd)
+	labelsRootCmd.AddCommand(labelsDeleteCmd)
+
+	labelsListCmd.Flags().StringP("team", "t", "", "Only list workspace labels and those of this team")
+	labelsListCmd.Flags().Bool("counts", false, "Count issues per label across all teams (slow on large workspaces)")
+	labelsListCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+
+	labelsCreateCmd.Flags().String("name", "", "Label name")
+	labelsCreateCmd.Flags().String("color", "", "Hex color, e.g. #4ea7fc")
+	labelsCreateCmd.Flags().String("parent", "", "Label group to put the label in")
+	labelsCreateCmd.Flags().StringP("team", "t", "", "Create a team label instead of a workspace label")
+	labelsCreateCmd.Flags().Bool("group", false, "Create a label group")
+
+	for _, cmd := range []*cobra.Command{labelsRenameCmd, labelsMergeCmd, labelsDeleteCmd} {
+		cmd.Flags().StringP("team", "t", "", "Team whose labels take precedence")
+	}
+	labelsMergeCmd.Flags().Bool("dry-run", false, "Only list the issues that would be relabeled")
+	labelsMergeCmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	labelsDeleteCmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+}
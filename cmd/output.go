@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// printJSON writes v to stdout as indented JSON, exiting on failure.
+func printJSON(v any) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding JSON output: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// outputFormat reads the --output flag and rejects unknown values.
+func outputFormat(value string) string {
+	switch value {
+	case "", "text":
+		return "text"
+	case "json":
+		return "json"
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown output format '%s' (expected 'text' or 'json')\n", value)
+		os.Exit(1)
+		return ""
+	}
+}
+
+// formatDuration renders d with the largest sensible units, e.g. "3d 4h".
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	days := int(d / (24 * time.Hour))
+	d -= time.Duration(days) * 24 * time.Hour
+	hours := int(d / time.Hour)
+	d -= time.Duration(hours) * time.Hour
+	minutes := int(d / time.Minute)
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// relativeTime renders t relative to now, e.g. "3 hours ago", falling back
+// to the date for anything older than a month.
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s ago", unit)
+		}
+		return fmt.Sprintf("%d %ss ago", n, unit)
+	}
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return plural(int(d/time.Minute), "minute")
+	case d < 24*time.Hour:
+		return plural(int(d/time.Hour), "hour")
+	case d < 48*time.Hour:
+		return "yesterday"
+	case d < 30*24*time.Hour:
+		return plural(int(d/(24*time.Hour)), "day")
+	default:
+		return t.Local().Format("2006-01-02")
+	}
+}
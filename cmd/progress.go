@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// progressBar draws a single-line progress bar on stderr. When stderr is
+// not a terminal it stays silent so logs are not flooded. It is safe for
+// concurrent use.
+type progressBar struct {
+	mu     sync.Mutex
+	total  int
+	done   int
+	failed int
+	tty    bool
+}
+
+func newProgressBar(total int) *progressBar {
+	info, err := os.Stderr.Stat()
+	p := &progressBar{
+		total: total,
+		tty:   err == nil && info.Mode()&os.ModeCharDevice != 0,
+	}
+	p.draw()
+	return p
+}
+
+// add records a finished item.
+func (p *progressBar) add(ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	if !ok {
+		p.failed++
+	}
+	p.draw()
+}
+
+// printf prints a line above the bar.
+func (p *progressBar) printf(format string, args ...any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tty {
+		fmt.Fprint(os.Stderr, "\r\033[K")
+	}
+	fmt.Fprintf(os.Stderr, format, args...)
+	p.draw()
+}
+
+// finish ends the bar's line.
+func (p *progressBar) finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tty {
+		fmt.Fprintln(os.Stderr)
+	}
+}
+
+func (p *progressBar) draw() {
+	if !p.tty || p.total == 0 {
+		return
+	}
+	const width = 30
+	filled := p.done * width / p.total
+	line := fmt.Sprintf("\r[%s%s] %d/%d",
+		strings.Repeat("#", filled), strings.Repeat("-", width-filled), p.done, p.total)
+	if p.failed > 0 {
+		line += fmt.Sprintf(" (%d failed)", p.failed)
+	}
+	fmt.Fprint(os.Stderr, line+"\033[K")
+}
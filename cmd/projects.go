@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+var projectsRootCmd = &cobra.Command{
+	Use:     "projects",
+	Aliases: []string{"project"},
+	Short:   "List, view and manage projects",
+	Long: `Lists, shows, creates, updates and archives projects. Projects are given by
+ID or name, ignoring case; part of a name is enough if it matches a single
+active project. Archived projects need their ID or full name. When the
+project is omitted on a terminal, it is picked from a list. Changes are
+journaled and can be reverted with 'linear-cli undo'.`,
+}
+
+var projectsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List projects with their state, lead, target date and progress",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		teamName, _ := cmd.Flags().GetString("team")
+		archived, _ := cmd.Flags().GetBool("archived")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		projects, err := linear.FetchProjects(apiKey, optionalTeamID(apiKey, teamName), archived)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		if format == "json" {
+			printJSON(projects)
+			return
+		}
+		if len(projects) == 0 {
+			fmt.Println("No projects found.")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSTATE\tLEAD\tTARGET\tPROGRESS\t")
+		for _, project := range projects {
+			state := orNone(project.StatusName())
+			if project.ArchivedAt != nil {
+				state += " (archived)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
+				truncate(project.Name, 40), state, orNone(project.LeadName()),
+				orNone(project.TargetDate), formatProgress(project.Progress))
+		}
+		w.Flush()
+	},
+}
+
+var projectsViewCmd = &cobra.Command{
+	Use:   "view [project]",
+	Short: "Show a project with its milestones, issues and updates",
+	Long: `Shows a project's fields and description, its milestones, its issues
+grouped by workflow state and its latest project updates (--updates sets how
+many, 0 for none).`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+		updateCount, _ := cmd.Flags().GetInt("updates")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		project := resolveProject(apiKey, args)
+		details, err := linear.FetchProjectDetails(apiKey, project.ID, updateCount)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		issues, err := linear.FetchIssues(apiKey, linear.IssueQuery{
+			Filter: map[string]any{"project": map[string]any{"id": map[string]any{"eq": project.ID}}},
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching issues: %v\n", err)
+			os.Exit(1)
+		}
+
+		if format == "json" {
+			printJSON(struct {
+				*linear.ProjectDetails
+				Issues []linear.IssueNode `json:"issues"`
+			}{details, issues})
+			return
+		}
+		printProject(details, issues, time.Now())
+	},
+}
+
+// printProject prints a project's fields, milestones, issues by state and
+// updates.
+func printProject(project *linear.ProjectDetails, issues []linear.IssueNode, now time.Time) {
+	fmt.Printf("  Project: %s (%s)\n", project.Name, project.ID)
+	fmt.Printf("  State: %s\n", orNone(project.StatusName()))
+	fmt.Printf("  Lead: %s\n", orNone(project.LeadName()))
+	if project.Teams != nil && len(project.Teams.Nodes) > 0 {
+		teams := make([]string, len(project.Teams.Nodes))
+		for i, team := range project.Teams.Nodes {
+			teams[i] = team.Name
+		}
+		fmt.Printf("  Teams: %s\n", strings.Join(teams, ", "))
+	}
+	if project.StartDate != "" {
+		fmt.Printf("  Start: %s\n", project.StartDate)
+	}
+	if project.TargetDate != "" {
+		fmt.Printf("  Target: %s\n", project.TargetDate)
+	}
+	fmt.Printf("  Progress: %s\n", formatProgress(project.Progress))
+	if project.ArchivedAt != nil {
+		fmt.Printf("  Archived: %s\n", project.ArchivedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if project.URL != "" {
+		fmt.Printf("  URL: %s\n", project.URL)
+	}
+	if description := strings.TrimSpace(project.Description); description != "" {
+		fmt.Println("  Description:")
+		for _, line := range strings.Split(description, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+
+	if len(project.Milestones) > 0 {
+		fmt.Printf("  Milestones (%d):\n", len(project.Milestones))
+		for _, milestone := range project.Milestones {
+			if milestone.TargetDate != "" {
+				fmt.Printf("    %s (%s)\n", milestone.Name, milestone.TargetDate)
+			} else {
+				fmt.Printf("    %s\n", milestone.Name)
+			}
+		}
+	}
+
+	fmt.Printf("  Issues (%d):\n", len(issues))
+	for _, group := range groupIssuesByState(issues) {
+		fmt.Printf("    %s (%d):\n", group[0].State.Name, len(group))
+		for _, issue := range group {
+			assignee := ""
+			if issue.Assignee != nil {
+				assignee = "  @" + issue.Assignee.Name
+			}
+			fmt.Printf("      %s  %s%s\n", issue.Identifier, truncate(issue.Title, 60), assignee)
+		}
+	}
+
+	if len(project.Updates) > 0 {
+		fmt.Println("  Updates:")
+		for _, update := range project.Updates {
+			author := "Unknown"
+			if update.User != nil {
+				author = update.User.Name
+			}
+			fmt.Printf("    %s, %s (%s):\n", author, relativeTime(update.CreatedAt, now), projectHealth(update.Health))
+			for _, line := range strings.Split(strings.TrimSpace(update.Body), "\n") {
+				fmt.Printf("      %s\n", line)
+			}
+		}
+	}
+}
+
+// projectStateOrder lists the state types with work in progress first.
+var projectStateOrder = []string{"started", "unstarted", "backlog", "triage", "completed", "canceled"}
+
+// groupIssuesByState groups issues by state, ordering the groups by
+// projectStateOrder and then by name.
+func groupIssuesByState(issues []linear.IssueNode) [][]linear.IssueNode {
+	var groups [][]linear.IssueNode
+	index := map[string]int{}
+	for _, issue := range issues {
+		i, ok := index[issue.State.ID]
+		if !ok {
+			i = len(groups)
+			index[issue.State.ID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], issue)
+	}
+	slices.SortStableFunc(groups, func(a, b []linear.IssueNode) int {
+		if d := slices.Index(projectStateOrder, a[0].State.Type) - slices.Index(projectStateOrder, b[0].State.Type); d != 0 {
+			return d
+		}
+		return strings.Compare(a[0].State.Name, b[0].State.Name)
+	})
+	return groups
+}
+
+func formatProgress(progress float64) string {
+	return fmt.Sprintf("%.0f%%", progress*100)
+}
+
+func projectHealth(health string) string {
+	switch health {
+	case "onTrack":
+		return "on track"
+	case "atRisk":
+		return "at risk"
+	case "offTrack":
+		return "off track"
+	}
+	return orNone(health)
+}
+
+// resolveProject finds the project named by the first argument, or lets the
+// user pick one on a terminal, exiting on failure. Archived projects are
+// found by ID or exact name only, and are not offered in the picker.
+func resolveProject(apiKey string, args []string) *linear.ProjectNode {
+	if len(args) == 0 && !stdinIsTerminal() {
+		fmt.Fprintln(os.Stderr, "Error: a project is required.")
+		os.Exit(1)
+	}
+	projects, err := linear.FetchProjects(apiKey, "", len(args) > 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(args) > 0 {
+		// An exact match wins, preferring active projects; partial names
+		// only match active projects.
+		var active []linear.ProjectNode
+		var archived *linear.ProjectNode
+		for i, project := range projects {
+			exact := project.ID == args[0] || strings.EqualFold(project.Name, args[0])
+			switch {
+			case exact && project.ArchivedAt == nil:
+				return &projects[i]
+			case exact && archived == nil:
+				archived = &projects[i]
+			case project.ArchivedAt == nil:
+				active = append(active, project)
+			}
+		}
+		if archived != nil {
+			return archived
+		}
+		project, err := linear.FindProject(active, args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return project
+	}
+	if len(projects) == 0 {
+		fmt.Fprintln(os.Stderr, "No projects found.")
+		os.Exit(1)
+	}
+	names := make([]string, len(projects))
+	for i, project := range projects {
+		names[i] = project.Name
+	}
+	index, err := promptForSelect("Select Project", names, 0)
+	if err != nil {
+		exitOnPromptError("Project selection", err)
+	}
+	return &projects[index]
+}
+
+func init() {
+	rootCmd.AddCommand(projectsRootCmd)
+	projectsRootCmd.AddCommand(projectsListCmd)
+	projectsRootCmd.AddCommand(projectsViewCmd)
+	projectsRootCmd.AddCommand(projectsCreateCmd)
+	projectsRootCmd.AddCommand(projectsUpdateCmd)
+	projectsRootCmd.AddCommand(projectsArchiveCmd)
+
+	projectsListCmd.Flags().StringP("team", "t", "", "Only list the projects of this team")
+	projectsListCmd.Flags().Bool("archived", false, "Include archived projects")
+	projectsListCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+
+	projectsViewCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+	projectsViewCmd.Flags().Int("updates", 3, "Number of latest project updates to show")
+}
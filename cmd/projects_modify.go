@@ -0,0 +1,516 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+var projectsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a project",
+	Long: `Creates a project in one or more teams. Every field can be given as a flag,
+using names rather than IDs; anything missing is prompted for when stdin is a
+terminal. With --no-input, a missing name or team is an error instead.
+
+The lead is @me, an email, or a full or partial name of a member of the
+project's teams. The state is a project status name such as "Planned".`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		teamNames, _ := cmd.Flags().GetStringArray("team")
+		lead, _ := cmd.Flags().GetString("lead")
+		state, _ := cmd.Flags().GetString("state")
+		startDate, _ := cmd.Flags().GetString("start-date")
+		targetDate, _ := cmd.Flags().GetString("target-date")
+		noInput, _ := cmd.Flags().GetBool("no-input")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+		interactive := !noInput && stdinIsTerminal()
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		if strings.TrimSpace(name) == "" {
+			if !interactive {
+				fmt.Fprintln(os.Stderr, "Error: --name is required.")
+				os.Exit(1)
+			}
+			var err error
+			if name, err = promptForRequiredString("Project Name"); err != nil {
+				exitOnPromptError("Prompt", err)
+			}
+		}
+		if interactive && !cmd.Flags().Changed("description") {
+			descriptionPrompt := promptui.Prompt{Label: "Project Description (Optional)"}
+			var err error
+			if description, err = descriptionPrompt.Run(); err != nil {
+				exitOnPromptError("Prompt", err)
+			}
+		}
+		input := linear.ProjectCreateInput{
+			Name:        strings.TrimSpace(name),
+			Description: strings.TrimSpace(description),
+		}
+
+		r := newFieldResolver(apiKey)
+		for _, teamName := range teamNames {
+			team, err := r.team(teamName)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			input.TeamIDs = append(input.TeamIDs, team.ID)
+		}
+		if len(input.TeamIDs) == 0 {
+			if !interactive {
+				fmt.Fprintln(os.Stderr, "Error: at least one --team is required.")
+				os.Exit(1)
+			}
+			input.TeamIDs = []string{selectTeamInteractively(apiKey)}
+		}
+
+		if lead != "" || interactive {
+			members, err := projectMembers(r, input.TeamIDs)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			if lead != "" {
+				user, err := r.matchMember(members, lead, interactive)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+				input.LeadID = user.ID
+			} else if user := promptProjectLead(members, ""); user != nil {
+				input.LeadID = user.ID
+			}
+		}
+
+		if state != "" || interactive {
+			statuses, err := linear.FetchProjectStatuses(apiKey)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			var status *linear.ProjectStatusNode
+			if state != "" {
+				if status, err = linear.FindProjectStatus(statuses, state); err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+			} else {
+				status = promptProjectStatus(statuses, "")
+			}
+			input.StatusID = status.ID
+		}
+
+		if interactive && targetDate == "" {
+			targetDate = promptDate("Target date", "")
+		}
+		for _, date := range []struct{ what, value string }{{"start date", startDate}, {"target date", targetDate}} {
+			if err := validateDate(date.what, date.value); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		input.StartDate = startDate
+		input.TargetDate = targetDate
+
+		fmt.Fprintln(os.Stderr, "Creating project...")
+		project, err := createProject(apiKey, input)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating project: %v\n", err)
+			os.Exit(1)
+		}
+		if format == "json" {
+			printJSON(project)
+			return
+		}
+		fmt.Fprintln(os.Stderr, "Project created successfully!")
+		fmt.Printf("%s %s\n", project.Name, project.URL)
+	},
+}
+
+var projectsUpdateCmd = &cobra.Command{
+	Use:   "update [project]",
+	Short: "Update the name, description, state, lead or dates of a project",
+	Long: `Updates the fields given as flags. Without flags on a terminal, the name,
+state, lead and target date are prompted for with their current values
+preselected. "none" clears the lead, start date or target date.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		noInput, _ := cmd.Flags().GetBool("no-input")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		format := outputFormat(outputFlag)
+		interactive := !noInput && stdinIsTerminal()
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		flagsSet := false
+		for _, flag := range []string{"name", "description", "lead", "state", "start-date", "target-date"} {
+			flagsSet = flagsSet || cmd.Flags().Changed(flag)
+		}
+		if !flagsSet && !interactive {
+			fmt.Fprintln(os.Stderr, "Error: nothing to update; pass --name, --description, --lead, --state, --start-date or --target-date.")
+			os.Exit(1)
+		}
+
+		project := resolveProject(apiKey, args)
+		update := &projectUpdate{project: project, input: map[string]any{}}
+		r := newFieldResolver(apiKey)
+		var err error
+		if flagsSet {
+			err = applyProjectFlags(cmd, r, update, interactive)
+		} else {
+			err = promptProjectFields(r, update)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(update.input) == 0 {
+			fmt.Printf("%s: nothing to change\n", project.Name)
+			return
+		}
+		updated, err := updateProject(apiKey, update)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error updating project: %v\n", err)
+			os.Exit(1)
+		}
+		if format == "json" {
+			printJSON(updated)
+			return
+		}
+		fmt.Printf("%s: %s\n", updated.Name, strings.Join(update.summary(), ", "))
+	},
+}
+
+var projectsArchiveCmd = &cobra.Command{
+	Use:   "archive [project]",
+	Short: "Archive a project",
+	Long: `Archives a project after asking for confirmation, unless --yes is given.
+Its issues are kept. Archived projects are listed with 'projects list
+--archived'.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		project := resolveProject(apiKey, args)
+		if !yes {
+			if !stdinIsTerminal() {
+				fmt.Fprintln(os.Stderr, "Error: confirmation required; re-run with --yes.")
+				os.Exit(1)
+			}
+			if !confirm(fmt.Sprintf("Archive project '%s'", project.Name)) {
+				fmt.Fprintln(os.Stderr, "Aborted.")
+				return
+			}
+		}
+
+		if err := archiveProject(apiKey, project); err != nil {
+			fmt.Fprintf(os.Stderr, "Error archiving project: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Archived project '%s'\n", project.Name)
+	},
+}
+
+// projectUpdate collects the ProjectUpdateInput fields whose new value
+// differs from the project, with their previous values for the journal.
+type projectUpdate struct {
+	project *linear.ProjectNode
+	input   map[string]any
+	changes []fieldChange
+}
+
+func (u *projectUpdate) record(field, key string, before, after any, from, to string) {
+	u.input[key] = after
+	u.changes = append(u.changes, fieldChange{
+		Field:  field,
+		Key:    key,
+		Before: before,
+		After:  after,
+		From:   from,
+		To:     to,
+	})
+}
+
+// summary renders the changes as "field: from -> to" lines.
+func (u *projectUpdate) summary() []string {
+	lines := make([]string, len(u.changes))
+	for i, change := range u.changes {
+		lines[i] = fmt.Sprintf("%s: %s -> %s", change.Field, change.From, change.To)
+	}
+	return lines
+}
+
+func (u *projectUpdate) setName(name string) {
+	if name != u.project.Name {
+		u.record("name", "name", u.project.Name, name, strconv.Quote(u.project.Name), strconv.Quote(name))
+	}
+}
+
+func (u *projectUpdate) setDescription(description string) {
+	if strings.TrimSpace(description) != strings.TrimSpace(u.project.Description) {
+		u.record("description", "description", u.project.Description, description,
+			describeText(u.project.Description), describeText(description))
+	}
+}
+
+func (u *projectUpdate) setStatus(status *linear.ProjectStatusNode) {
+	if u.project.Status == nil {
+		u.record("state", "statusId", nil, status.ID, "none", status.Name)
+	} else if status.ID != u.project.Status.ID {
+		u.record("state", "statusId", u.project.Status.ID, status.ID, u.project.StatusName(), status.Name)
+	}
+}
+
+// setLead sets the lead; nil removes it.
+func (u *projectUpdate) setLead(lead *linear.UserNode) {
+	var current any
+	if u.project.Lead != nil {
+		current = u.project.Lead.ID
+	}
+	if lead == nil {
+		if current != nil {
+			u.record("lead", "leadId", current, nil, u.project.LeadName(), "none")
+		}
+	} else if lead.ID != current {
+		u.record("lead", "leadId", current, lead.ID, orNone(u.project.LeadName()), lead.Name)
+	}
+}
+
+func (u *projectUpdate) setStartDate(date string) {
+	if date != u.project.StartDate {
+		u.record("start date", "startDate", nilIfEmpty(u.project.StartDate), nilIfEmpty(date),
+			orNone(u.project.StartDate), orNone(date))
+	}
+}
+
+func (u *projectUpdate) setTargetDate(date string) {
+	if date != u.project.TargetDate {
+		u.record("target date", "targetDate", nilIfEmpty(u.project.TargetDate), nilIfEmpty(date),
+			orNone(u.project.TargetDate), orNone(date))
+	}
+}
+
+// applyProjectFlags records the changes requested by flags in update.
+func applyProjectFlags(cmd *cobra.Command, r *fieldResolver, update *projectUpdate, interactive bool) error {
+	flags := cmd.Flags()
+	if flags.Changed("name") {
+		name, _ := flags.GetString("name")
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("the name cannot be empty")
+		}
+		update.setName(strings.TrimSpace(name))
+	}
+	if flags.Changed("description") {
+		description, _ := flags.GetString("description")
+		update.setDescription(description)
+	}
+	if flags.Changed("state") {
+		state, _ := flags.GetString("state")
+		statuses, err := linear.FetchProjectStatuses(r.apiKey)
+		if err != nil {
+			return err
+		}
+		status, err := linear.FindProjectStatus(statuses, state)
+		if err != nil {
+			return err
+		}
+		update.setStatus(status)
+	}
+	if flags.Changed("lead") {
+		lead, _ := flags.GetString("lead")
+		if isNone(lead) {
+			update.setLead(nil)
+		} else {
+			members, err := projectMembers(r, update.project.TeamIDs())
+			if err != nil {
+				return err
+			}
+			user, err := r.matchMember(members, lead, interactive)
+			if err != nil {
+				return err
+			}
+			update.setLead(user)
+		}
+	}
+	for _, date := range []struct {
+		flag, what string
+		set        func(string)
+	}{
+		{"start-date", "start date", update.setStartDate},
+		{"target-date", "target date", update.setTargetDate},
+	} {
+		if !flags.Changed(date.flag) {
+			continue
+		}
+		value, _ := flags.GetString(date.flag)
+		if isNone(value) {
+			value = ""
+		}
+		if err := validateDate(date.what, value); err != nil {
+			return err
+		}
+		date.set(value)
+	}
+	return nil
+}
+
+// promptProjectFields prompts for the name, state, lead and target date of
+// a project, preselecting the current values.
+func promptProjectFields(r *fieldResolver, update *projectUpdate) error {
+	project := update.project
+	name, err := promptForString("Project Name", project.Name)
+	if err != nil {
+		exitOnPromptError("Prompt", err)
+	}
+	if strings.TrimSpace(name) != "" {
+		update.setName(strings.TrimSpace(name))
+	}
+
+	statuses, err := linear.FetchProjectStatuses(r.apiKey)
+	if err != nil {
+		return err
+	}
+	current := ""
+	if project.Status != nil {
+		current = project.Status.ID
+	}
+	update.setStatus(promptProjectStatus(statuses, current))
+
+	members, err := projectMembers(r, project.TeamIDs())
+	if err != nil {
+		return err
+	}
+	current = ""
+	if project.Lead != nil {
+		current = project.Lead.ID
+	}
+	update.setLead(promptProjectLead(members, current))
+
+	update.setTargetDate(promptDate("Target date", project.TargetDate))
+	return nil
+}
+
+// promptProjectLead asks for a lead among members; nil means no lead.
+func promptProjectLead(members *linear.TeamDetails, current string) *linear.UserNode {
+	names := []string{"No Lead"}
+	selected := 0
+	for i, member := range members.Members {
+		names = append(names, member.Name)
+		if member.ID == current {
+			selected = i + 1
+		}
+	}
+	index, err := promptForSelect("Select Lead", names, selected)
+	if err != nil {
+		exitOnPromptError("Lead selection", err)
+	}
+	if index == 0 {
+		return nil
+	}
+	return &members.Members[index-1]
+}
+
+func promptProjectStatus(statuses []linear.ProjectStatusNode, current string) *linear.ProjectStatusNode {
+	if len(statuses) == 0 {
+		fmt.Fprintln(os.Stderr, "No project statuses found.")
+		os.Exit(1)
+	}
+	names := make([]string, len(statuses))
+	selected := 0
+	for i, status := range statuses {
+		names[i] = status.Name
+		if status.ID == current {
+			selected = i
+		}
+	}
+	index, err := promptForSelect("Select State", names, selected)
+	if err != nil {
+		exitOnPromptError("State selection", err)
+	}
+	return &statuses[index]
+}
+
+// projectMembers merges the members of a project's teams into one
+// TeamDetails, so that the lead can be matched with matchMember.
+func projectMembers(r *fieldResolver, teamIDs []string) (*linear.TeamDetails, error) {
+	merged := &linear.TeamDetails{}
+	var names []string
+	for _, teamID := range teamIDs {
+		details, err := r.teamDetails(teamID)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, details.Name)
+		for _, member := range details.Members {
+			if !slices.ContainsFunc(merged.Members, func(m linear.UserNode) bool { return m.ID == member.ID }) {
+				merged.Members = append(merged.Members, member)
+			}
+		}
+	}
+	merged.Name = strings.Join(names, ", ")
+	return merged, nil
+}
+
+// validateDate checks that a non-empty value is a YYYY-MM-DD date.
+func validateDate(what, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", what, value)
+	}
+	return nil
+}
+
+func init() {
+	projectsCreateCmd.Flags().String("name", "", "Project name")
+	projectsCreateCmd.Flags().String("description", "", "Project description (markdown)")
+	projectsCreateCmd.Flags().StringArrayP("team", "t", nil, "Team name or key (repeatable)")
+	projectsCreateCmd.Flags().String("lead", "", "Lead: @me, email or name")
+	projectsCreateCmd.Flags().String("state", "", "Project status name (e.g. 'Planned')")
+	projectsCreateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
+	projectsCreateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
+	projectsCreateCmd.Flags().Bool("no-input", false, "Never prompt; fail if a required field is missing")
+	projectsCreateCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+
+	projectsUpdateCmd.Flags().String("name", "", "New project name")
+	projectsUpdateCmd.Flags().String("description", "", "New project description (markdown)")
+	projectsUpdateCmd.Flags().String("lead", "", "Lead: @me, email, name or 'none'")
+	projectsUpdateCmd.Flags().String("state", "", "Project status name (e.g. 'In Progress')")
+	projectsUpdateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD or 'none')")
+	projectsUpdateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD or 'none')")
+	projectsUpdateCmd.Flags().Bool("no-input", false, "Never prompt")
+	projectsUpdateCmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+
+	projectsArchiveCmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+// stdinIsTerminal reports whether stdin is attached to an interactive
+// terminal, i.e. whether it is safe to show promptui prompts.
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// exitOnPromptError reports a failed prompt and exits. Ctrl-C exits cleanly.
+func exitOnPromptError(what string, err error) {
+	fmt.Fprintf(os.Stderr, "%s failed: %v\n", what, err)
+	if err == promptui.ErrInterrupt {
+		os.Exit(0)
+	}
+	os.Exit(1)
+}
+
+// promptForRequiredString prompts until a non-empty value is entered.
+func promptForRequiredString(label string) (string, error) {
+	prompt := promptui.Prompt{
+		Label: label,
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("%s cannot be empty", strings.ToLower(label))
+			}
+			return nil
+		},
+	}
+	return prompt.Run()
+}
+
+// promptForMultiSelect lets the user toggle items on and off until "Done"
+// is chosen, and returns the final selection.
+func promptForMultiSelect(label string, items []string, selected []bool) ([]bool, error) {
+	selected = append([]bool(nil), selected...)
+	cursor := 0
+	for {
+		display := make([]string, len(items)+1)
+		display[0] = "Done"
+		for i, item := range items {
+			mark := "[ ]"
+			if selected[i] {
+				mark = "[x]"
+			}
+			display[i+1] = mark + " " + item
+		}
+
+		prompt := promptui.Select{
+			Label:     label + " (select to toggle)",
+			Items:     display,
+			CursorPos: cursor,
+			Size:      10,
+			Searcher: func(input string, index int) bool {
+				return strings.Contains(strings.ToLower(display[index]), strings.ToLower(input))
+			},
+		}
+		index, _, err := prompt.Run()
+		if err != nil {
+			return nil, err
+		}
+		if index == 0 {
+			return selected, nil
+		}
+		selected[index-1] = !selected[index-1]
+		cursor = index
+	}
+}
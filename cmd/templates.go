@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/editor"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+	"github.com/Matthew-K310/linear-cli/internal/templates"
+)
+
+var templatesRootCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manage local issue templates",
+	Long: `Issue templates are markdown files with YAML frontmatter, stored in
+~/.config/linear_cli/templates (user-level) or .linear/templates at the root of
+the current git repository (repo-level, takes precedence).`,
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available issue templates",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		list, err := templates.List()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading templates: %v\n", err)
+			os.Exit(1)
+		}
+		if len(list) == 0 {
+			fmt.Println("No templates found. Create one with `linear-cli templates new <name>`.")
+			return
+		}
+		for _, tpl := range list {
+			fmt.Printf("%-20s %-5s %s\n", tpl.Name, tpl.Source, tpl.About)
+		}
+	},
+}
+
+var templatesShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Print an issue template",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tpl, err := templates.Find(args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("# %s (%s)\n", tpl.Path, tpl.Source)
+		fmt.Print(string(tpl.Content))
+	},
+}
+
+var templatesNewCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Create a new issue template and open it in $EDITOR",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		repo, _ := cmd.Flags().GetBool("repo")
+
+		dir, err := templates.UserDir()
+		if repo {
+			root := templates.RepoRoot()
+			if root == "" {
+				fmt.Fprintln(os.Stderr, "Error: --repo requires running inside a git repository.")
+				os.Exit(1)
+			}
+			dir, err = filepath.Join(root, templates.RepoDir), nil
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		path := filepath.Join(dir, args[0]+".md")
+		if _, err := os.Stat(path); err == nil {
+			fmt.Fprintf(os.Stderr, "Error: template %s already exists.\n", path)
+			os.Exit(1)
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", dir, err)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(path, []byte(templates.Skeleton), 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing template: %v\n", err)
+			os.Exit(1)
+		}
+
+		if stdinIsTerminal() {
+			if err := editor.Open(path); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		if _, err := templates.Load(path); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		fmt.Printf("Template saved to %s\n", path)
+	},
+}
+
+var templatesSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Download Linear's issue templates as local templates",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		force, _ := cmd.Flags().GetBool("force")
+
+		apiKey := config.GetAPIKey()
+		if apiKey == "" {
+			fmt.Fprintln(os.Stderr, "Error: API_KEY is not set.")
+			os.Exit(1)
+		}
+
+		remote, err := linear.FetchIssueTemplates(apiKey)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		dir, err := templates.UserDir()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", dir, err)
+			os.Exit(1)
+		}
+
+		r := newFieldResolver(apiKey)
+		for _, tpl := range remote {
+			path := filepath.Join(dir, slugify(tpl.Name)+".md")
+			if _, err := os.Stat(path); err == nil && !force {
+				fmt.Printf("Skipping %s: %s exists (use --force to overwrite)\n", tpl.Name, path)
+				continue
+			}
+
+			file := templateFile{About: tpl.Description}
+			if file.About == "" {
+				file.About = "Synced from Linear"
+			}
+			file.Title = tpl.Data.Title
+			details := &linear.TeamDetails{}
+			if tpl.Team != nil {
+				file.Team = tpl.Team.Key
+				if details, err = r.teamDetails(tpl.Team.ID); err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			syncedFieldNames(&file, tpl, details)
+			if tpl.Data.Priority != nil {
+				file.Priority = linear.PriorityName(*tpl.Data.Priority)
+			}
+			if tpl.Data.Estimate != nil {
+				file.Estimate = strconv.Itoa(*tpl.Data.Estimate)
+			}
+
+			content, err := editor.RenderFrontmatter(file, tpl.Data.Description)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error rendering %s: %v\n", tpl.Name, err)
+				os.Exit(1)
+			}
+			if err := os.WriteFile(path, content, 0o644); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", path, err)
+				os.Exit(1)
+			}
+			fmt.Printf("Synced %s -> %s\n", tpl.Name, path)
+		}
+	},
+}
+
+// syncedFieldNames fills in the project, assignee, state and labels of a
+// synced template by name, looked up in the template team's details. IDs
+// that do not resolve, e.g. of workspace templates, are kept as they are.
+func syncedFieldNames(file *templateFile, tpl linear.IssueTemplate, details *linear.TeamDetails) {
+	data := tpl.Data
+	file.Project, file.Assignee, file.State = data.ProjectID, data.AssigneeID, data.StateID
+	if project, err := details.FindProject(data.ProjectID); data.ProjectID != "" && err == nil {
+		file.Project = project.Name
+	}
+	if member, err := details.FindMember(data.AssigneeID); data.AssigneeID != "" && err == nil {
+		file.Assignee = member.Name
+	}
+	if state, err := details.FindState(data.StateID); data.StateID != "" && err == nil {
+		file.State = state.Name
+	}
+	file.Labels = nil
+	for _, id := range data.LabelIDs {
+		if label, err := details.FindLabel(id); err == nil {
+			id = label.Path()
+		}
+		file.Labels = append(file.Labels, id)
+	}
+}
+
+// templateFile is the frontmatter of a template: issue fields plus the
+// template's own metadata.
+type templateFile struct {
+	About       string             `yaml:"about"`
+	Prompts     []templates.Prompt `yaml:"prompts,omitempty"`
+	issueFields `yaml:",inline"`
+}
+
+// fieldsFromTemplate loads the named template, asks for its custom prompts
+// (or takes them from vars) and renders every placeholder.
+func fieldsFromTemplate(name string, vars map[string]string, interactive bool) (issueFields, error) {
+	tpl, err := templates.Find(name)
+	if err != nil {
+		return issueFields{}, err
+	}
+
+	var file templateFile
+	body, err := editor.ParseFrontmatter(tpl.Content, &file)
+	if err != nil {
+		return issueFields{}, fmt.Errorf("template %s: %w", tpl.Name, err)
+	}
+
+	data := templates.Context()
+	for _, p := range file.Prompts {
+		value, ok := vars[p.Name]
+		if !ok && interactive {
+			label := p.Label
+			if label == "" {
+				label = p.Name
+			}
+			prompt := promptui.Prompt{Label: label, Default: p.Default}
+			value, err = prompt.Run()
+			if err != nil {
+				exitOnPromptError("Prompt", err)
+			}
+		} else if !ok {
+			value = p.Default
+		}
+		if p.Required && strings.TrimSpace(value) == "" {
+			return issueFields{}, fmt.Errorf("template %s needs a value for %q (use --var %s=...)", tpl.Name, p.Name, p.Name)
+		}
+		data[p.Name] = value
+	}
+	for key, value := range vars {
+		if _, ok := data[key]; !ok {
+			data[key] = value
+		}
+	}
+
+	fields := file.issueFields
+	render := func(s *string) {
+		if err != nil {
+			return
+		}
+		*s, err = templates.Render(*s, data)
+	}
+	render(&fields.Title)
+	render(&fields.Team)
+	render(&fields.Project)
+	render(&fields.Assignee)
+	render(&fields.State)
+	render(&fields.Priority)
+	render(&fields.Estimate)
+	render(&fields.Due)
+	render(&fields.Cycle)
+	render(&fields.Parent)
+	for i := range fields.Labels {
+		render(&fields.Labels[i])
+	}
+	if err != nil {
+		return issueFields{}, fmt.Errorf("template %s: %w", tpl.Name, err)
+	}
+
+	// The description is free text that may contain a literal "{{", such
+	// as a code sample or a template synced from Linear, so it is kept as
+	// written when it does not render.
+	fields.Description = strings.TrimSpace(body)
+	if description, err := templates.Render(fields.Description, data); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: template %s: description used as written: %v\n", tpl.Name, err)
+	} else {
+		fields.Description = description
+	}
+
+	return fields, nil
+}
+
+// mergeFields returns base with every non-empty field of override applied.
+func mergeFields(base, override issueFields) issueFields {
+	set := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+	set(&base.Title, override.Title)
+	set(&base.Description, override.Description)
+	set(&base.Team, override.Team)
+	set(&base.Project, override.Project)
+	set(&base.Assignee, override.Assignee)
+	set(&base.State, override.State)
+	set(&base.Priority, override.Priority)
+	set(&base.Estimate, override.Estimate)
+	set(&base.Due, override.Due)
+	set(&base.Cycle, override.Cycle)
+	set(&base.Parent, override.Parent)
+	if len(override.Labels) > 0 {
+		base.Labels = override.Labels
+	}
+	return base
+}
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+func slugify(s string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+func init() {
+	rootCmd.AddCommand(templatesRootCmd)
+	templatesRootCmd.AddCommand(templatesListCmd)
+	templatesRootCmd.AddCommand(templatesShowCmd)
+	templatesRootCmd.AddCommand(templatesNewCmd)
+	templatesRootCmd.AddCommand(templatesSyncCmd)
+
+	templatesNewCmd.Flags().Bool("repo", false, "Create the template in the repository's .linear/templates")
+	templatesSyncCmd.Flags().Bool("force", false, "Overwrite existing local templates")
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/cache"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// viewerCacheTTL is how long the current user is cached.
+const viewerCacheTTL = 7 * 24 * time.Hour
+
+// currentUser returns the user the API key belongs to. It is cached per
+// profile and API key, so switching keys never returns the wrong user.
+func currentUser(apiKey string) (*linear.UserNode, error) {
+	sum := sha256.Sum256([]byte(apiKey))
+	name := "viewer-" + hex.EncodeToString(sum[:6])
+
+	var viewer linear.UserNode
+	if cache.Load(name, viewerCacheTTL, &viewer) && viewer.ID != "" {
+		return &viewer, nil
+	}
+	fetched, err := linear.FetchViewer(apiKey)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Save(name, fetched)
+	return fetched, nil
+}
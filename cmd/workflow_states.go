@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/cache"
+	"github.com/Matthew-K310/linear-cli/internal/linear"
+)
+
+// workflowStateCacheTTL is how long a team's workflow states are cached.
+// Workflows rarely change; a state that is not found triggers a refetch.
+const workflowStateCacheTTL = 24 * time.Hour
+
+// teamWorkflowStates returns a team's workflow states from the cache,
+// fetching them if they are missing, stale or refresh is set.
+func teamWorkflowStates(apiKey, teamID string, refresh bool) (linear.WorkflowStates, error) {
+	name := "states-" + teamID
+	var states linear.WorkflowStates
+	if !refresh && cache.Load(name, workflowStateCacheTTL, &states) {
+		return states, nil
+	}
+	states, err := linear.FetchWorkflowStates(apiKey, teamID)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Save(name, states)
+	return states, nil
+}
+
+// findWorkflowState resolves a state name or ID within a team, refetching
+// the cached states once if the state is unknown.
+func findWorkflowState(apiKey, teamID, nameOrID string) (*linear.StateNode, error) {
+	for _, refresh := range []bool{false, true} {
+		states, err := teamWorkflowStates(apiKey, teamID, refresh)
+		if err != nil {
+			return nil, err
+		}
+		if state, ok := states.Find(nameOrID); ok {
+			return state, nil
+		}
+	}
+	return nil, fmt.Errorf("state %q not found", nameOrID)
+}
@@ -5,6 +5,7 @@ go 1.24.4
 require (
 	github.com/99designs/gqlgen v0.17.74
 	github.com/vektah/gqlparser/v2 v2.5.27
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -3,9 +3,14 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
 )
 
 const GraphQLEndpoint = "https://api.linear.app/graphql" // Define the endpoint here
@@ -22,6 +27,61 @@ type GraphQLResponse struct {
 	Errors json.RawMessage `json:"errors"`
 }
 
+// maxRateLimitRetries is how often a rate-limited request is retried.
+const maxRateLimitRetries = 3
+
+// rateLimit tracks the request budget reported by the API so concurrent
+// callers back off before hitting the limit.
+var rateLimit struct {
+	sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+// waitForRateLimit blocks while the known request budget is exhausted,
+// telling the user why on stderr.
+func waitForRateLimit() {
+	rateLimit.Lock()
+	wait := time.Duration(0)
+	if rateLimit.remaining <= 1 && !rateLimit.reset.IsZero() {
+		wait = time.Until(rateLimit.reset)
+	}
+	rateLimit.Unlock()
+	if wait > 0 {
+		sleepForRateLimit(wait)
+	}
+}
+
+// sleepForRateLimit waits out the rate limit with a note on stderr, so that
+// long waits don't look like a hang.
+func sleepForRateLimit(wait time.Duration) {
+	fmt.Fprintf(os.Stderr, "Rate limited by the Linear API, waiting %ds...\n", int(wait.Round(time.Second)/time.Second))
+	time.Sleep(wait)
+}
+
+// recordRateLimit stores the budget from the X-RateLimit-Requests-* headers
+// and returns how long to wait before retrying, if the API reported a reset.
+func recordRateLimit(header http.Header) time.Duration {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Requests-Remaining"))
+	if err != nil {
+		return 0
+	}
+	var reset time.Time
+	if ms, err := strconv.ParseInt(header.Get("X-RateLimit-Requests-Reset"), 10, 64); err == nil {
+		reset = time.UnixMilli(ms)
+	}
+
+	rateLimit.Lock()
+	rateLimit.remaining = remaining
+	rateLimit.reset = reset
+	rateLimit.Unlock()
+
+	if reset.IsZero() {
+		return 0
+	}
+	return time.Until(reset)
+}
+
 // MakeGraphQLRequest sends a GraphQL query to the Linear API endpoint.
 // It requires the API key, the query string, and optional variables.
 // It returns the raw JSON data from the 'data' field or an error.
@@ -44,6 +104,36 @@ func MakeGraphQLRequest(
 		return nil, fmt.Errorf("failed to marshal GraphQL request body: %w", err)
 	}
 
+	for attempt := 0; ; attempt++ {
+		waitForRateLimit()
+		data, err := doGraphQLRequest(apiKey, bodyBytes)
+
+		var limited *rateLimitError
+		if !errors.As(err, &limited) || attempt >= maxRateLimitRetries {
+			return data, err
+		}
+
+		wait := limited.resetIn
+		if wait <= 0 || wait > time.Minute {
+			wait = time.Duration(attempt+1) * 2 * time.Second
+		}
+		sleepForRateLimit(wait)
+	}
+}
+
+// rateLimitError is returned when the API rejected a request for exceeding
+// the rate limit.
+type rateLimitError struct {
+	resetIn time.Duration
+	body    string
+}
+
+func (e *rateLimitError) Error() string {
+	return "GraphQL request was rate limited: " + e.body
+}
+
+// doGraphQLRequest performs a single request.
+func doGraphQLRequest(apiKey string, bodyBytes []byte) ([]byte, error) {
 	req, err := http.NewRequest("POST", GraphQLEndpoint, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GraphQL request: %w", err)
@@ -60,12 +150,17 @@ func MakeGraphQLRequest(
 		return nil, fmt.Errorf("GraphQL request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	resetIn := recordRateLimit(resp.Header)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read GraphQL response body: %w", err)
 	}
 
+	if resp.StatusCode == http.StatusTooManyRequests || isRateLimited(respBody) {
+		return nil, &rateLimitError{resetIn: resetIn, body: string(respBody)}
+	}
+
 	// Check for non-OK status codes (e.g., 401, 403, 400, 500)
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf(
@@ -94,3 +189,25 @@ func MakeGraphQLRequest(
 
 	return graphQLResp.Data, nil
 }
+
+// isRateLimited reports whether a response body carries a GraphQL error with
+// the RATELIMITED code. Only the errors are inspected, since the data may
+// contain anything.
+func isRateLimited(respBody []byte) bool {
+	var resp struct {
+		Errors []struct {
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return false
+	}
+	for _, e := range resp.Errors {
+		if e.Extensions.Code == "RATELIMITED" {
+			return true
+		}
+	}
+	return false
+}
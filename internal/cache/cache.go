@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+)
+
+// entry is the on-disk form of a cached value.
+type entry struct {
+	SavedAt time.Time       `json:"savedAt"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// path returns the cache file for name. Caches are kept per profile since
+// profiles may point at different workspaces.
+func path(name string) (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cache", config.Profile(), name+".json"), nil
+}
+
+// Load decodes the cached value called name into v. It reports false if
+// there is none, it is older than maxAge, or it cannot be read.
+func Load(name string, maxAge time.Duration, v any) bool {
+	p, err := path(name)
+	if err != nil {
+		return false
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return false
+	}
+	var cached entry
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return false
+	}
+	if time.Since(cached.SavedAt) > maxAge {
+		return false
+	}
+	return json.Unmarshal(cached.Data, v) == nil
+}
+
+// Save stores v under name.
+func Save(name string, v any) error {
+	p, err := path(name)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding cache %s: %w", name, err)
+	}
+	data, err = json.Marshal(entry{SavedAt: time.Now(), Data: data})
+	if err != nil {
+		return fmt.Errorf("encoding cache %s: %w", name, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	return os.WriteFile(p, data, 0o600)
+}
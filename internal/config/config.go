@@ -5,6 +5,8 @@ import (
 	"log"
 	"os"
 	"path/filepath" // Import the filepath package
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -15,6 +17,67 @@ func GetAPIKey() string {
 	return apiKey
 }
 
+// GetDefaultTeam returns the team key used to expand bare issue numbers
+// (e.g. "123" -> "ENG-123"). It is read from DEFAULT_TEAM.
+func GetDefaultTeam() string {
+	return os.Getenv("DEFAULT_TEAM")
+}
+
+// Profile returns the name of the active profile, read from LINEAR_PROFILE.
+// It defaults to "default".
+func Profile() string {
+	if profile := os.Getenv("LINEAR_PROFILE"); profile != "" {
+		return profile
+	}
+	return "default"
+}
+
+// Dir returns the CLI's configuration directory (~/.config/linear_cli).
+func Dir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "linear_cli"), nil
+}
+
+// CreateWithEditor reports whether `issues create` should open $EDITOR by
+// default. It is enabled by setting CREATE_EDITOR=true.
+func CreateWithEditor() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("CREATE_EDITOR"))
+	return enabled
+}
+
+// TeamSetting returns the setting name for a team, read from
+// <NAME>_<TEAMKEY> (e.g. START_STATE_ENG) and falling back to <NAME>.
+func TeamSetting(name, teamKey string) string {
+	if value := os.Getenv(name + "_" + strings.ToUpper(teamKey)); value != "" {
+		return value
+	}
+	return os.Getenv(name)
+}
+
+// HiddenStateTypes returns the workflow state types hidden from issue
+// pickers unless --include-closed is given. It is read from
+// HIDDEN_STATE_TYPES as a comma-separated list, defaulting to completed and
+// canceled; "none" hides nothing.
+func HiddenStateTypes() []string {
+	value := os.Getenv("HIDDEN_STATE_TYPES")
+	if value == "" {
+		return []string{"completed", "canceled"}
+	}
+	if strings.EqualFold(value, "none") {
+		return nil
+	}
+	var types []string
+	for _, t := range strings.Split(value, ",") {
+		if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
 func Load() error {
 	// --- MODIFIED SECTION ---
 
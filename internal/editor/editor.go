@@ -0,0 +1,34 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Command returns the user's editor, preferring $VISUAL over $EDITOR and
+// falling back to vi. Blank values are ignored.
+func Command() string {
+	if visual := strings.TrimSpace(os.Getenv("VISUAL")); visual != "" {
+		return visual
+	}
+	if editor := strings.TrimSpace(os.Getenv("EDITOR")); editor != "" {
+		return editor
+	}
+	return "vi"
+}
+
+// Open runs the user's editor on path and waits for it to exit. The editor
+// command may contain arguments, e.g. "code --wait".
+func Open(path string) error {
+	parts := strings.Fields(Command())
+	cmd := exec.Command(parts[0], append(parts[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running editor %q: %w", Command(), err)
+	}
+	return nil
+}
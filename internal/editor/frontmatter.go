@@ -0,0 +1,66 @@
+package editor
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const delimiter = "---"
+
+// ParseFrontmatter splits a markdown document into its YAML frontmatter,
+// decoded into v, and the remaining body. A document without frontmatter
+// leaves v untouched and returns the whole content as body.
+func ParseFrontmatter(content []byte, v any) (string, error) {
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if !strings.HasPrefix(text, delimiter+"\n") {
+		return text, nil
+	}
+
+	rest := text[len(delimiter)+1:]
+	var front, body string
+	if strings.HasPrefix(rest, delimiter) {
+		body = rest[len(delimiter):]
+	} else {
+		end := strings.Index(rest, "\n"+delimiter)
+		if end < 0 {
+			return "", fmt.Errorf("frontmatter is not terminated by a %q line", delimiter)
+		}
+		front = rest[:end]
+		body = rest[end+1+len(delimiter):]
+	}
+	body = strings.TrimPrefix(body, "\n")
+
+	if err := yaml.Unmarshal([]byte(front), v); err != nil {
+		return "", fmt.Errorf("invalid frontmatter: %w", err)
+	}
+	return strings.TrimLeft(body, "\n"), nil
+}
+
+// RenderFrontmatter encodes v as YAML frontmatter followed by body. Each line
+// in comments is written as a YAML comment at the top of the frontmatter.
+func RenderFrontmatter(v any, body string, comments ...string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(delimiter + "\n")
+	for _, comment := range comments {
+		buf.WriteString("# " + comment + "\n")
+	}
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return nil, fmt.Errorf("encoding frontmatter: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("encoding frontmatter: %w", err)
+	}
+
+	buf.WriteString(delimiter + "\n\n")
+	buf.WriteString(body)
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,137 @@
+// Package emoji maps the emoji shortcodes Linear stores for reactions to
+// their unicode characters, so that reactions render in a terminal.
+package emoji
+
+import "strings"
+
+// shortcodes maps shortcode names, without colons, to unicode emoji.
+var shortcodes = map[string]string{
+	"+1":                       "👍",
+	"-1":                       "👎",
+	"100":                      "💯",
+	"alarm_clock":              "⏰",
+	"bangbang":                 "‼️",
+	"beers":                    "🍻",
+	"blush":                    "😊",
+	"boom":                     "💥",
+	"brain":                    "🧠",
+	"broken_heart":             "💔",
+	"bug":                      "🐛",
+	"bulb":                     "💡",
+	"calendar":                 "📆",
+	"chart_with_upwards_trend": "📈",
+	"clap":                     "👏",
+	"coffee":                   "☕",
+	"confused":                 "😕",
+	"construction":             "🚧",
+	"cry":                      "😢",
+	"dart":                     "🎯",
+	"eyes":                     "👀",
+	"fire":                     "🔥",
+	"gem":                      "💎",
+	"ghost":                    "👻",
+	"green_heart":              "💚",
+	"grimacing":                "😬",
+	"grin":                     "😁",
+	"grinning":                 "😀",
+	"hammer":                   "🔨",
+	"heart":                    "❤️",
+	"heart_eyes":               "😍",
+	"heavy_check_mark":         "✔️",
+	"hourglass":                "⌛",
+	"hugs":                     "🤗",
+	"hushed":                   "😯",
+	"joy":                      "😂",
+	"laughing":                 "😆",
+	"lock":                     "🔒",
+	"mag":                      "🔍",
+	"memo":                     "📝",
+	"muscle":                   "💪",
+	"ok":                       "🆗",
+	"ok_hand":                  "👌",
+	"open_mouth":               "😮",
+	"partying_face":            "🥳",
+	"pencil2":                  "✏️",
+	"point_up":                 "☝️",
+	"pray":                     "🙏",
+	"purple_heart":             "💜",
+	"question":                 "❓",
+	"raised_hands":             "🙌",
+	"raising_hand":             "🙋",
+	"recycle":                  "♻️",
+	"rocket":                   "🚀",
+	"rotating_light":           "🚨",
+	"sad":                      "😞",
+	"see_no_evil":              "🙈",
+	"shipit":                   "🐿️",
+	"skull":                    "💀",
+	"slightly_smiling_face":    "🙂",
+	"smile":                    "😄",
+	"smiley":                   "😃",
+	"sob":                      "😭",
+	"sparkles":                 "✨",
+	"star":                     "⭐",
+	"star_struck":              "🤩",
+	"sunglasses":               "😎",
+	"sweat_smile":              "😅",
+	"tada":                     "🎉",
+	"thinking":                 "🤔",
+	"trophy":                   "🏆",
+	"turtle":                   "🐢",
+	"upside_down_face":         "🙃",
+	"warning":                  "⚠️",
+	"wave":                     "👋",
+	"white_check_mark":         "✅",
+	"wink":                     "😉",
+	"x":                        "❌",
+	"zap":                      "⚡",
+}
+
+// aliases maps alternative shortcodes to the names in shortcodes.
+var aliases = map[string]string{
+	"thumbsup":      "+1",
+	"thumbs_up":     "+1",
+	"thumbsdown":    "-1",
+	"thumbs_down":   "-1",
+	"check":         "white_check_mark",
+	"hooray":        "tada",
+	"laugh":         "laughing",
+	"satisfied":     "laughing",
+	"hugging_face":  "hugs",
+	"red_heart":     "heart",
+	"party":         "partying_face",
+	"thinking_face": "thinking",
+}
+
+// Name normalizes a reaction given as ":name:", "name", an alias or the
+// unicode emoji itself to the shortcode name Linear stores. Names that are
+// not in the table, such as custom workspace emoji, are returned as given
+// without colons.
+func Name(input string) string {
+	name := strings.Trim(strings.TrimSpace(input), ":")
+	lower := strings.ToLower(name)
+	if _, ok := shortcodes[lower]; ok {
+		return lower
+	}
+	if canonical, ok := aliases[lower]; ok {
+		return canonical
+	}
+	// Emoji may or may not carry the emoji presentation selector.
+	bare := strings.TrimSuffix(name, "\ufe0f")
+	for code, char := range shortcodes {
+		if strings.TrimSuffix(char, "\ufe0f") == bare {
+			return code
+		}
+	}
+	return name
+}
+
+// Unicode returns the unicode emoji for a shortcode name, or the name in
+// colons if it is unknown.
+func Unicode(name string) string {
+	name = Name(name)
+	if char, ok := shortcodes[name]; ok {
+		return char
+	}
+	return ":" + name + ":"
+}
@@ -0,0 +1,212 @@
+package graph
+
+import "sort"
+
+// Edge kinds.
+const (
+	// Blocks points from the blocking issue to the blocked one.
+	Blocks = "blocks"
+	// Parent points from a parent issue to its child.
+	Parent = "parent"
+)
+
+// Node is an issue in the graph.
+type Node struct {
+	ID        string  `json:"id"`
+	Label     string  `json:"label"`
+	Title     string  `json:"title"`
+	State     string  `json:"state"`
+	StateType string  `json:"stateType"`
+	Weight    float64 `json:"weight"`
+	// External marks issues outside the selection that are related to it.
+	External bool `json:"external,omitempty"`
+}
+
+// Edge is a relation between two nodes, by node ID.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+// Graph is a dependency graph of issues.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+	index map[string]int
+}
+
+// AddNode adds a node unless one with the same ID exists. A selected node
+// replaces an external one.
+func (g *Graph) AddNode(node Node) {
+	if g.index == nil {
+		g.index = map[string]int{}
+	}
+	if i, ok := g.index[node.ID]; ok {
+		if g.Nodes[i].External && !node.External {
+			g.Nodes[i] = node
+		}
+		return
+	}
+	g.index[node.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, node)
+}
+
+// AddEdge adds an edge unless it exists already.
+func (g *Graph) AddEdge(edge Edge) {
+	for _, e := range g.Edges {
+		if e == edge {
+			return
+		}
+	}
+	g.Edges = append(g.Edges, edge)
+}
+
+// Node returns the node with the given ID.
+func (g *Graph) Node(id string) (Node, bool) {
+	i, ok := g.index[id]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// successors returns the adjacency lists of the edges of a kind.
+func (g *Graph) successors(kind string) map[string][]string {
+	next := map[string][]string{}
+	for _, edge := range g.Edges {
+		if edge.Kind == kind {
+			next[edge.From] = append(next[edge.From], edge.To)
+		}
+	}
+	return next
+}
+
+// Cycles returns the dependency cycles among edges of a kind: the strongly
+// connected components with more than one node, or a node that depends on
+// itself. Each cycle lists node IDs in graph order.
+func (g *Graph) Cycles(kind string) [][]string {
+	next := g.successors(kind)
+
+	// Tarjan's algorithm.
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var cycles [][]string
+	counter := 0
+
+	var visit func(id string)
+	visit = func(id string) {
+		index[id] = counter
+		low[id] = counter
+		counter++
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, to := range next[id] {
+			if _, seen := index[to]; !seen {
+				visit(to)
+				low[id] = min(low[id], low[to])
+			} else if onStack[to] {
+				low[id] = min(low[id], index[to])
+			}
+		}
+
+		if low[id] != index[id] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == id {
+				break
+			}
+		}
+		selfLoop := false
+		for _, to := range next[id] {
+			selfLoop = selfLoop || to == id
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Slice(component, func(i, j int) bool { return g.index[component[i]] < g.index[component[j]] })
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, node := range g.Nodes {
+		if _, seen := index[node.ID]; !seen {
+			visit(node.ID)
+		}
+	}
+	return cycles
+}
+
+// CriticalPath returns the heaviest chain of edges of a kind, by the sum of
+// node weights, and its total weight. Edges within cycles are ignored so
+// the result is well defined.
+func (g *Graph) CriticalPath(kind string) ([]string, float64) {
+	inCycle := map[string]int{}
+	for i, cycle := range g.Cycles(kind) {
+		for _, id := range cycle {
+			inCycle[id] = i + 1
+		}
+	}
+	next := map[string][]string{}
+	for from, targets := range g.successors(kind) {
+		for _, to := range targets {
+			if inCycle[from] == 0 || inCycle[from] != inCycle[to] {
+				next[from] = append(next[from], to)
+			}
+		}
+	}
+
+	// best[id] is the weight of the heaviest path starting at id.
+	best := map[string]float64{}
+	following := map[string]string{}
+	visiting := map[string]bool{}
+	var weigh func(id string) float64
+	weigh = func(id string) float64 {
+		if w, ok := best[id]; ok {
+			return w
+		}
+		if visiting[id] {
+			// Only reachable through a cycle across components; treat
+			// the node as a dead end.
+			return 0
+		}
+		visiting[id] = true
+		node, _ := g.Node(id)
+		w := node.Weight
+		for _, to := range next[id] {
+			if candidate := node.Weight + weigh(to); candidate > w {
+				w = candidate
+				following[id] = to
+			}
+		}
+		visiting[id] = false
+		best[id] = w
+		return w
+	}
+
+	var start string
+	var total float64
+	for _, node := range g.Nodes {
+		if w := weigh(node.ID); w > total {
+			start, total = node.ID, w
+		}
+	}
+	if start == "" {
+		return nil, 0
+	}
+	path := []string{start}
+	for id := following[start]; id != ""; id = following[id] {
+		path = append(path, id)
+	}
+	if len(path) < 2 {
+		return nil, 0
+	}
+	return path, total
+}
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// build returns a graph of nodes with the given weights, in the order
+// given, and "blocks" edges written as "A>B".
+func build(weights []float64, ids string, edges ...string) *Graph {
+	g := &Graph{}
+	for i, id := range ids {
+		g.AddNode(Node{ID: string(id), Label: string(id), Weight: weights[i]})
+	}
+	for _, edge := range edges {
+		g.AddEdge(Edge{From: edge[:1], To: edge[2:], Kind: Blocks})
+	}
+	return g
+}
+
+func TestCyclesAndCriticalPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		graph  *Graph
+		cycles [][]string
+		path   []string
+		total  float64
+	}{
+		{
+			name:  "chain",
+			graph: build([]float64{1, 2, 3}, "ABC", "A>B", "B>C"),
+			path:  []string{"A", "B", "C"},
+			total: 6,
+		},
+		{
+			name:  "diamond takes the heavier branch",
+			graph: build([]float64{1, 1, 5, 1}, "ABCD", "A>B", "A>C", "B>D", "C>D"),
+			path:  []string{"A", "C", "D"},
+			total: 7,
+		},
+		{
+			name:   "two-cycle has no path",
+			graph:  build([]float64{1, 1}, "AB", "A>B", "B>A"),
+			cycles: [][]string{{"A", "B"}},
+		},
+		{
+			name:   "self-loop is a cycle but its other edges count",
+			graph:  build([]float64{2, 3}, "AB", "A>A", "A>B"),
+			cycles: [][]string{{"A"}},
+			path:   []string{"A", "B"},
+			total:  5,
+		},
+		{
+			name:   "cycle feeding a chain",
+			graph:  build([]float64{1, 1, 1, 1}, "ABCD", "A>B", "B>A", "B>C", "C>D"),
+			cycles: [][]string{{"A", "B"}},
+			path:   []string{"B", "C", "D"},
+			total:  3,
+		},
+		{
+			name: "parent edges are ignored",
+			graph: func() *Graph {
+				g := build([]float64{1, 1}, "AB")
+				g.AddEdge(Edge{From: "A", To: "B", Kind: Parent})
+				return g
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if cycles := tt.graph.Cycles(Blocks); !reflect.DeepEqual(cycles, tt.cycles) {
+				t.Errorf("Cycles() = %v, want %v", cycles, tt.cycles)
+			}
+			path, total := tt.graph.CriticalPath(Blocks)
+			if !reflect.DeepEqual(path, tt.path) || total != tt.total {
+				t.Errorf("CriticalPath() = %v, %v, want %v, %v", path, total, tt.path, tt.total)
+			}
+		})
+	}
+}
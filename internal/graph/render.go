@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// stateTypes orders the workflow state types for stable output.
+var stateTypes = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+// stateColors are the node fill colors by workflow state type.
+var stateColors = map[string]string{
+	"triage":    "#fcd9c4",
+	"backlog":   "#e5e7eb",
+	"unstarted": "#f3f4f6",
+	"started":   "#fde68a",
+	"completed": "#bbf7d0",
+	"canceled":  "#d1d5db",
+}
+
+const (
+	defaultColor  = "#ffffff"
+	criticalColor = "#dc2626"
+	cycleColor    = "#f97316"
+)
+
+// Annotations are the analysis results highlighted when rendering.
+type Annotations struct {
+	CriticalPath []string
+	Cycles       [][]string
+}
+
+func (a Annotations) onCriticalPath(edge Edge) bool {
+	for i := 0; i+1 < len(a.CriticalPath); i++ {
+		if a.CriticalPath[i] == edge.From && a.CriticalPath[i+1] == edge.To {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Annotations) inCycle(edge Edge) bool {
+	for _, cycle := range a.Cycles {
+		from, to := false, false
+		for _, id := range cycle {
+			from = from || id == edge.From
+			to = to || id == edge.To
+		}
+		if from && to {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Annotations) critical(id string) bool {
+	for _, pathID := range a.CriticalPath {
+		if pathID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func fillColor(stateType string) string {
+	if color, ok := stateColors[stateType]; ok {
+		return color
+	}
+	return defaultColor
+}
+
+func shorten(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// DOT renders the graph in Graphviz format. Blocking relations are solid
+// arrows and parent/child relations dashed; the critical path is drawn in
+// red and edges within dependency cycles in orange.
+func (g *Graph) DOT(a Annotations) string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph issues {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+	for _, node := range g.Nodes {
+		attrs := []string{
+			"label=" + quote(node.Label+"\n"+shorten(node.Title, 40)+"\n"+node.State),
+			"fillcolor=" + quote(fillColor(node.StateType)),
+		}
+		if node.External {
+			attrs = append(attrs, `style="rounded,filled,dashed"`)
+		}
+		if a.critical(node.ID) {
+			attrs = append(attrs, "color="+quote(criticalColor), "penwidth=2")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", quote(node.Label), strings.Join(attrs, ", "))
+	}
+	for _, edge := range g.Edges {
+		from, _ := g.Node(edge.From)
+		to, _ := g.Node(edge.To)
+		var attrs []string
+		if edge.Kind == Parent {
+			attrs = append(attrs, "style=dashed", "arrowhead=none", `label="parent"`)
+		}
+		switch {
+		case a.inCycle(edge) && edge.Kind == Blocks:
+			attrs = append(attrs, "color="+quote(cycleColor), "penwidth=2", `xlabel="cycle"`)
+		case a.onCriticalPath(edge):
+			attrs = append(attrs, "color="+quote(criticalColor), "penwidth=2")
+		}
+		fmt.Fprintf(&b, "  %s -> %s", quote(from.Label), quote(to.Label))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart with the same styling
+// as DOT.
+func (g *Graph) Mermaid(a Annotations) string {
+	escape := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace
+	ids := map[string]string{}
+	for i, node := range g.Nodes {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, node := range g.Nodes {
+		label := node.Label + "<br/>" + escape(shorten(node.Title, 40)) + "<br/><i>" + escape(node.State) + "</i>"
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", ids[node.ID], label)
+	}
+
+	var cycleLinks, criticalLinks []string
+	for i, edge := range g.Edges {
+		arrow := "-->"
+		if edge.Kind == Parent {
+			arrow = "-.-|parent|"
+		}
+		fmt.Fprintf(&b, "  %s %s %s\n", ids[edge.From], arrow, ids[edge.To])
+		switch {
+		case a.inCycle(edge) && edge.Kind == Blocks:
+			cycleLinks = append(cycleLinks, fmt.Sprint(i))
+		case a.onCriticalPath(edge):
+			criticalLinks = append(criticalLinks, fmt.Sprint(i))
+		}
+	}
+
+	for _, stateType := range stateTypes {
+		fmt.Fprintf(&b, "  classDef %s fill:%s,stroke:#6b7280\n", stateType, stateColors[stateType])
+	}
+	b.WriteString("  classDef external stroke-dasharray:4 4\n")
+	fmt.Fprintf(&b, "  classDef critical stroke:%s,stroke-width:2px\n", criticalColor)
+	for _, node := range g.Nodes {
+		if _, ok := stateColors[node.StateType]; ok {
+			fmt.Fprintf(&b, "  class %s %s\n", ids[node.ID], node.StateType)
+		}
+		if node.External {
+			fmt.Fprintf(&b, "  class %s external\n", ids[node.ID])
+		}
+		if a.critical(node.ID) {
+			fmt.Fprintf(&b, "  class %s critical\n", ids[node.ID])
+		}
+	}
+	if len(criticalLinks) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:%s,stroke-width:3px\n", strings.Join(criticalLinks, ","), criticalColor)
+	}
+	if len(cycleLinks) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:%s,stroke-width:3px\n", strings.Join(cycleLinks, ","), cycleColor)
+	}
+	return b.String()
+}
@@ -0,0 +1,199 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+)
+
+// Actions recorded in the journal.
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionComment   = "comment"
+	ActionRelate    = "relate"
+	ActionUnrelate  = "unrelate"
+	ActionArchive   = "archive"
+	ActionUnarchive = "unarchive"
+	ActionDelete    = "delete"
+	ActionRestore   = "restore"
+	ActionUndo      = "undo"
+
+	// ActionCommentEdit and ActionCommentDelete keep the previous body in
+	// a "body" change so that undo can restore it.
+	ActionCommentEdit   = "comment-edit"
+	ActionCommentDelete = "comment-delete"
+
+	// ActionReact and ActionUnreact keep the emoji in an "emoji" change.
+	ActionReact   = "react"
+	ActionUnreact = "unreact"
+
+	// Label entries have no issue; TargetID and TargetName are the label's
+	// ID and path. ActionLabelRename keeps the old name in a "name" change
+	// and ActionLabelDelete keeps the label's fields as the before values
+	// of its changes, so that undo can create it again.
+	ActionLabelCreate = "label-create"
+	ActionLabelRename = "label-rename"
+	ActionLabelDelete = "label-delete"
+
+	// Project entries have no issue either; TargetID and TargetName are the
+	// project's ID and name.
+	ActionProjectCreate  = "project-create"
+	ActionProjectUpdate  = "project-update"
+	ActionProjectArchive = "project-archive"
+)
+
+// Change is a single field change with its raw before and after values, as
+// sent to the API, and their display forms.
+type Change struct {
+	Field  string `json:"field"`
+	Key    string `json:"key"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// Entry is one mutation performed by the CLI.
+type Entry struct {
+	ID         string    `json:"id"`
+	Time       time.Time `json:"time"`
+	Profile    string    `json:"profile"`
+	Command    string    `json:"command"`
+	Action     string    `json:"action"`
+	IssueID    string    `json:"issueId,omitempty"`
+	Identifier string    `json:"identifier,omitempty"`
+	// TargetID is the ID of a non-issue entity the action created or
+	// changed, such as a comment, relation, label or project.
+	TargetID string `json:"targetId,omitempty"`
+	// TargetName is the name of the label or project an entry is about,
+	// for display.
+	TargetName string `json:"targetName,omitempty"`
+	// RelatedIssueID, RelatedIdentifier and RelationType describe the
+	// relation of relate and unrelate entries: Issue <type> RelatedIssue.
+	RelatedIssueID    string `json:"relatedIssueId,omitempty"`
+	RelatedIdentifier string `json:"relatedIdentifier,omitempty"`
+	RelationType      string `json:"relationType,omitempty"`
+	// ParentID is the parent comment of a deleted reply.
+	ParentID string `json:"parentId,omitempty"`
+	// CommentID is the comment of a reaction entry; reactions to the issue
+	// itself have none.
+	CommentID string   `json:"commentId,omitempty"`
+	Changes   []Change `json:"changes,omitempty"`
+	// UpdatedAt is the issue's updatedAt right after the mutation; undo
+	// uses it to detect changes made since.
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	// Undoes is the ID of the entry an undo entry reverted.
+	Undoes string `json:"undoes,omitempty"`
+}
+
+// Path returns the location of the journal file.
+func Path() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "journal.jsonl"), nil
+}
+
+// Append adds an entry to the journal, filling in its ID, time, profile and
+// command line.
+func Append(entry Entry) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating journal directory: %w", err)
+	}
+
+	now := time.Now()
+	if entry.ID == "" {
+		entry.ID = strconv.FormatInt(now.UnixNano(), 36)
+	}
+	if entry.Time.IsZero() {
+		entry.Time = now
+	}
+	if entry.Profile == "" {
+		entry.Profile = config.Profile()
+	}
+	if entry.Command == "" {
+		entry.Command = strings.Join(os.Args, " ")
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding journal entry: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening journal: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing journal: %w", err)
+	}
+	return nil
+}
+
+// Read returns every entry of the given profile, oldest first. An empty
+// profile returns the entries of all profiles.
+func Read(profile string) ([]Entry, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening journal: %w", err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("journal line %d: %w", line, err)
+		}
+		if profile == "" || entry.Profile == profile {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// Undoable returns the entries that can still be undone, newest first:
+// everything except undo entries and entries that were already undone.
+func Undoable(entries []Entry) []Entry {
+	undone := map[string]bool{}
+	for _, entry := range entries {
+		if entry.Action == ActionUndo {
+			undone[entry.Undoes] = true
+		}
+	}
+
+	var undoable []Entry
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Action != ActionUndo && !undone[entry.ID] {
+			undoable = append(undoable, entry)
+		}
+	}
+	return undoable
+}
@@ -0,0 +1,254 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// CommentFields is the selection set used whenever comments are fetched.
+const CommentFields = `
+	id
+	body
+	url
+	createdAt
+	updatedAt
+	editedAt
+	user {
+		id
+		name
+		displayName
+	}
+	parent {
+		id
+	}
+	issue {
+		id
+		identifier
+	}
+	reactions {
+		id
+		emoji
+		user { id name }
+	}
+`
+
+// CommentNode is a comment on an issue.
+type CommentNode struct {
+	ID        string     `json:"id"`
+	Body      string     `json:"body"`
+	URL       string     `json:"url,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+	// User is nil for comments made by integrations.
+	User *UserNode `json:"user,omitempty"`
+	// Parent is set for replies.
+	Parent *struct {
+		ID string `json:"id"`
+	} `json:"parent,omitempty"`
+	Issue     *IssueRef      `json:"issue,omitempty"`
+	Reactions []ReactionNode `json:"reactions,omitempty"`
+}
+
+// Author returns the name of the comment's author.
+func (c CommentNode) Author() string {
+	if c.User == nil {
+		return "Integration"
+	}
+	if c.User.Name == "" {
+		return c.User.DisplayName
+	}
+	return c.User.Name
+}
+
+// ParentID returns the ID of the comment a reply answers, or "".
+func (c CommentNode) ParentID() string {
+	if c.Parent == nil {
+		return ""
+	}
+	return c.Parent.ID
+}
+
+// CommentCreateInput is the CommentCreateInput of a new comment. ParentID
+// makes it a reply.
+type CommentCreateInput struct {
+	IssueID  string `json:"issueId"`
+	ParentID string `json:"parentId,omitempty"`
+	Body     string `json:"body"`
+}
+
+// CreateComment adds a markdown comment to an issue.
+func CreateComment(apiKey string, input CommentCreateInput) (*CommentNode, error) {
+	mutation := `
+	mutation CreateComment($input: CommentCreateInput!) {
+		commentCreate(input: $input) {
+			success
+			comment {` + CommentFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("creating comment: %w", err)
+	}
+
+	var response struct {
+		CommentCreate struct {
+			Success bool        `json:"success"`
+			Comment CommentNode `json:"comment"`
+		} `json:"commentCreate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling comment response: %w", err)
+	}
+	if !response.CommentCreate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.CommentCreate.Comment, nil
+}
+
+// UpdateComment replaces the body of a comment.
+func UpdateComment(apiKey, id, body string) (*CommentNode, error) {
+	mutation := `
+	mutation UpdateComment($id: String!, $input: CommentUpdateInput!) {
+		commentUpdate(id: $id, input: $input) {
+			success
+			comment {` + CommentFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{
+		"id":    id,
+		"input": map[string]any{"body": body},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating comment: %w", err)
+	}
+
+	var response struct {
+		CommentUpdate struct {
+			Success bool        `json:"success"`
+			Comment CommentNode `json:"comment"`
+		} `json:"commentUpdate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling comment response: %w", err)
+	}
+	if !response.CommentUpdate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.CommentUpdate.Comment, nil
+}
+
+// DeleteComment deletes a comment.
+func DeleteComment(apiKey, id string) error {
+	mutation := `
+	mutation DeleteComment($id: String!) {
+		commentDelete(id: $id) {
+			success
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("deleting comment: %w", err)
+	}
+
+	var response struct {
+		CommentDelete struct {
+			Success bool `json:"success"`
+		} `json:"commentDelete"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling delete comment response: %w", err)
+	}
+	if !response.CommentDelete.Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
+
+// FetchComment returns a single comment by ID.
+func FetchComment(apiKey, id string) (*CommentNode, error) {
+	query := `
+	query Comment($id: String!) {
+		comment(id: $id) {` + CommentFields + `}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, map[string]any{"id": id})
+	if err != nil {
+		return nil, fmt.Errorf("fetching comment: %w", err)
+	}
+
+	var response struct {
+		Comment *CommentNode `json:"comment"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling comment: %w", err)
+	}
+	if response.Comment == nil {
+		return nil, fmt.Errorf("comment %s not found", id)
+	}
+	return response.Comment, nil
+}
+
+// FetchComments returns every comment on an issue, replies included,
+// oldest first.
+func FetchComments(apiKey, issueID string) ([]CommentNode, error) {
+	query := `
+	query IssueComments($id: String!, $after: String) {
+		issue(id: $id) {
+			comments(first: 100, after: $after) {
+				nodes {` + CommentFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}
+	`
+
+	var comments []CommentNode
+	after := ""
+	for {
+		variables := map[string]any{"id": issueID}
+		if after != "" {
+			variables["after"] = after
+		}
+		data, err := api.MakeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("fetching comments: %w", err)
+		}
+
+		var response struct {
+			Issue *struct {
+				Comments struct {
+					Nodes    []CommentNode `json:"nodes"`
+					PageInfo PageInfo      `json:"pageInfo"`
+				} `json:"comments"`
+			} `json:"issue"`
+		}
+		if err := json.Unmarshal(data, &response); err != nil {
+			return nil, fmt.Errorf("unmarshalling comments: %w", err)
+		}
+		if response.Issue == nil {
+			return nil, fmt.Errorf("issue %s not found", issueID)
+		}
+		comments = append(comments, response.Issue.Comments.Nodes...)
+		page := response.Issue.Comments.PageInfo
+		if !page.HasNextPage || page.EndCursor == "" {
+			break
+		}
+		after = page.EndCursor
+	}
+
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+	return comments, nil
+}
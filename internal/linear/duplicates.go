@@ -0,0 +1,133 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// DefaultDuplicateThreshold is the minimum similarity for an open issue to
+// be reported as a possible duplicate.
+const DefaultDuplicateThreshold = 0.45
+
+// maxSearchTokens bounds how many title words are sent to the search filter.
+const maxSearchTokens = 6
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "can": true, "for": true, "from": true,
+	"has": true, "have": true, "in": true, "is": true, "it": true, "not": true,
+	"of": true, "on": true, "or": true, "should": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "when": true, "with": true, "we": true,
+}
+
+// DuplicateCandidate is an open issue that looks similar to a new one.
+type DuplicateCandidate struct {
+	Issue IssueNode `json:"issue"`
+	Score float64   `json:"score"`
+}
+
+// Tokenize lower-cases text and splits it into words, dropping stop words
+// and single characters.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	tokens := words[:0]
+	for _, word := range words {
+		if len(word) > 1 && !stopWords[word] {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// jaccard returns the Jaccard index of two token sets.
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	setA := map[string]bool{}
+	for _, token := range a {
+		setA[token] = true
+	}
+	setB := map[string]bool{}
+	for _, token := range b {
+		setB[token] = true
+	}
+	shared := 0
+	for token := range setA {
+		if setB[token] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(setA)+len(setB)-shared)
+}
+
+// Similarity scores how alike two issues are, from 0 to 1. Titles weigh
+// more than descriptions; without a description only titles are compared.
+func Similarity(titleA, descriptionA, titleB, descriptionB string) float64 {
+	titleScore := jaccard(Tokenize(titleA), Tokenize(titleB))
+	if strings.TrimSpace(descriptionA) == "" {
+		return titleScore
+	}
+	fullScore := jaccard(
+		Tokenize(titleA+" "+descriptionA),
+		Tokenize(titleB+" "+descriptionB),
+	)
+	return 0.7*titleScore + 0.3*fullScore
+}
+
+// FindDuplicates searches the team's open issues for words of the title and
+// returns those scoring at least threshold, best match first.
+func FindDuplicates(apiKey, teamID, title, description string, threshold float64) ([]DuplicateCandidate, error) {
+	tokens := Tokenize(title)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(tokens, func(i, j int) bool { return len(tokens[i]) > len(tokens[j]) })
+	if len(tokens) > maxSearchTokens {
+		tokens = tokens[:maxSearchTokens]
+	}
+
+	var or []map[string]any
+	for _, token := range tokens {
+		or = append(or, map[string]any{"title": map[string]any{"containsIgnoreCase": token}})
+	}
+	filter := map[string]any{
+		"team":  map[string]any{"id": map[string]any{"eq": teamID}},
+		"state": map[string]any{"type": map[string]any{"nin": []string{"completed", "canceled"}}},
+		"or":    or,
+	}
+
+	query := `
+	query DuplicateCandidates($filter: IssueFilter) {
+		issues(filter: $filter, first: 100) {
+			nodes {` + IssueFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, map[string]any{"filter": filter})
+	if err != nil {
+		return nil, fmt.Errorf("searching for duplicates: %w", err)
+	}
+
+	var response IssuesResponseData
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling duplicate candidates: %w", err)
+	}
+
+	var candidates []DuplicateCandidate
+	for _, issue := range response.Issues.Nodes {
+		score := Similarity(title, description, issue.Title, issue.Description)
+		if score >= threshold {
+			candidates = append(candidates, DuplicateCandidate{Issue: issue, Score: score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	return candidates, nil
+}
@@ -0,0 +1,93 @@
+package linear
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EstimateOption is one point on a team's estimation scale.
+type EstimateOption struct {
+	Value int
+	Label string
+}
+
+var tShirtSizes = []string{"XS", "S", "M", "L", "XL", "XXL", "XXXL"}
+
+// EstimateScale returns the estimates the team accepts, based on its
+// issueEstimationType. It is empty when the team does not use estimates.
+func (t *TeamDetails) EstimateScale() []EstimateOption {
+	var values []int
+	switch t.IssueEstimationType {
+	case "exponential":
+		values = []int{1, 2, 4, 8, 16}
+		if t.IssueEstimationExtended {
+			values = append(values, 32, 64)
+		}
+	case "fibonacci", "tShirt":
+		values = []int{1, 2, 3, 5, 8}
+		if t.IssueEstimationExtended {
+			values = append(values, 13, 21)
+		}
+	case "linear":
+		values = []int{1, 2, 3, 4, 5}
+		if t.IssueEstimationExtended {
+			values = append(values, 6, 7)
+		}
+	default:
+		return nil
+	}
+	if t.IssueEstimationAllowZero {
+		values = append([]int{0}, values...)
+	}
+
+	options := make([]EstimateOption, 0, len(values))
+	offset := 0
+	if t.IssueEstimationAllowZero {
+		offset = 1
+	}
+	for i, value := range values {
+		label := strconv.Itoa(value)
+		if t.IssueEstimationType == "tShirt" {
+			if value == 0 {
+				label = "None"
+			} else {
+				label = tShirtSizes[i-offset]
+			}
+		}
+		options = append(options, EstimateOption{Value: value, Label: label})
+	}
+	return options
+}
+
+// EstimateLabel renders an estimate the way the team's scale names it.
+func (t *TeamDetails) EstimateLabel(value int) string {
+	for _, option := range t.EstimateScale() {
+		if option.Value == value {
+			return option.Label
+		}
+	}
+	return strconv.Itoa(value)
+}
+
+// ParseEstimate validates an estimate against the team's scale. T-shirt
+// sizes may be given by name (e.g. "M").
+func (t *TeamDetails) ParseEstimate(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	scale := t.EstimateScale()
+	if len(scale) == 0 {
+		return 0, fmt.Errorf("team %s does not use estimates", t.Name)
+	}
+
+	allowed := make([]string, len(scale))
+	for i, option := range scale {
+		allowed[i] = option.Label
+		if strings.EqualFold(option.Label, value) || strconv.Itoa(option.Value) == value {
+			return option.Value, nil
+		}
+	}
+	return 0, fmt.Errorf(
+		"estimate %q is not on team %s's %s scale (%s)",
+		value, t.Name, t.IssueEstimationType, strings.Join(allowed, ", "),
+	)
+}
@@ -0,0 +1,337 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// StateTypes lists Linear's workflow state types in workflow order.
+var StateTypes = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+// filterFields lists the fields a filter expression can use.
+var filterFields = []string{"team", "state", "type", "assignee", "creator", "project", "label", "cycle", "priority", "title"}
+
+// ParseFilter turns a filter expression into a Linear IssueFilter.
+//
+// An expression is a whitespace-separated list of terms that must all hold.
+// A term is field=value or field!=value; a comma-separated value matches
+// any of the values. title also supports ~ and !~ for substring matches.
+// Values containing spaces can be double-quoted:
+//
+//	team=ENG state=Todo,"In Progress" assignee=@me label!=blocked cycle=current
+//
+// Names compare case-insensitively. assignee and creator take @me, none, an
+// email or a (display) name; cycle takes current, next, previous, none or a
+// number; type takes a workflow state type such as started or completed.
+// A negated assignee, creator, project or cycle also matches issues that
+// have none.
+func ParseFilter(expr string) (map[string]any, error) {
+	terms, err := splitFilterTerms(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+
+	var filters []map[string]any
+	for _, term := range terms {
+		filter, err := parseFilterTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return map[string]any{"and": filters}, nil
+}
+
+// splitFilterTerms splits expr into terms at whitespace outside double
+// quotes, and each term into its comma-separated parts, stripping the
+// quotes. Splitting before the quotes are gone keeps quoted commas, as in
+// state="Done, Won't fix", inside their value.
+func splitFilterTerms(expr string) ([][]string, error) {
+	var terms [][]string
+	var parts []string
+	var part strings.Builder
+	inQuotes, inTerm := false, false
+	for _, r := range expr {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			inTerm = true
+		case unicode.IsSpace(r) && !inQuotes:
+			if inTerm {
+				terms = append(terms, append(parts, part.String()))
+				parts = nil
+				part.Reset()
+				inTerm = false
+			}
+		case r == ',' && !inQuotes:
+			parts = append(parts, part.String())
+			part.Reset()
+			inTerm = true
+		default:
+			part.WriteRune(r)
+			inTerm = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote in filter %q", expr)
+	}
+	if inTerm {
+		terms = append(terms, append(parts, part.String()))
+	}
+	return terms, nil
+}
+
+// parseFilterTerm parses a term split into its comma-separated parts; the
+// first part holds the field, the operator and the first value.
+func parseFilterTerm(parts []string) (map[string]any, error) {
+	term := strings.Join(parts, ",")
+	index := strings.IndexAny(parts[0], "=!~")
+	if index <= 0 {
+		return nil, fmt.Errorf("invalid filter term %q: expected field=value", term)
+	}
+	field := strings.ToLower(parts[0][:index])
+	rest := parts[0][index:]
+
+	var operator string
+	for _, op := range []string{"!=", "!~", "=", "~"} {
+		if strings.HasPrefix(rest, op) {
+			operator = op
+			break
+		}
+	}
+	if operator == "" {
+		return nil, fmt.Errorf("invalid filter term %q: expected =, !=, ~ or !~", term)
+	}
+	if field == "state-type" {
+		field = "type"
+	}
+	if field == "labels" {
+		field = "label"
+	}
+	if !slices.Contains(filterFields, field) {
+		return nil, fmt.Errorf("unknown filter field '%s' (expected one of %s)", field, strings.Join(filterFields, ", "))
+	}
+	if (operator == "~" || operator == "!~") && field != "title" {
+		return nil, fmt.Errorf("%s is only supported for title", operator)
+	}
+
+	negate := strings.HasPrefix(operator, "!")
+
+	var filters []map[string]any
+	values := append([]string{rest[len(operator):]}, parts[1:]...)
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		filter, err := fieldFilter(field, operator, v, negate)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	switch {
+	case len(filters) == 0:
+		return nil, fmt.Errorf("invalid filter term %q: missing value", term)
+	case len(filters) == 1:
+		return filters[0], nil
+	case negate:
+		return map[string]any{"and": filters}, nil
+	default:
+		return map[string]any{"or": filters}, nil
+	}
+}
+
+// fieldFilter builds the IssueFilter for a single value of a term.
+func fieldFilter(field, operator, value string, negate bool) (map[string]any, error) {
+	eq, eqIgnoreCase := "eq", "eqIgnoreCase"
+	if negate {
+		eq, eqIgnoreCase = "neq", "neqIgnoreCase"
+	}
+	is := func(v bool) map[string]any { return map[string]any{"eq": v != negate} }
+	lower := strings.ToLower(value)
+
+	switch field {
+	case "team":
+		return map[string]any{"team": nameOrKey("key", "name", value, negate)}, nil
+
+	case "state":
+		return map[string]any{"state": map[string]any{"name": map[string]any{eqIgnoreCase: value}}}, nil
+
+	case "type":
+		if err := ValidateStateTypes([]string{lower}); err != nil {
+			return nil, err
+		}
+		return map[string]any{"state": map[string]any{"type": map[string]any{eq: lower}}}, nil
+
+	case "assignee", "creator":
+		var user map[string]any
+		switch {
+		case lower == "@me" || lower == "me":
+			user = map[string]any{"isMe": is(true)}
+		case lower == "none":
+			user = map[string]any{"null": !negate}
+		case strings.Contains(value, "@") && !strings.HasPrefix(value, "@"):
+			user = map[string]any{"email": map[string]any{eqIgnoreCase: value}}
+		default:
+			user = nameOrKey("displayName", "name", strings.TrimPrefix(value, "@"), negate)
+		}
+		if lower == "none" {
+			return map[string]any{field: user}, nil
+		}
+		return orNull(field, user, negate), nil
+
+	case "project":
+		if lower == "none" {
+			return map[string]any{"project": map[string]any{"null": !negate}}, nil
+		}
+		return orNull("project", map[string]any{"name": map[string]any{eqIgnoreCase: value}}, negate), nil
+
+	case "label":
+		name := map[string]any{"name": map[string]any{"eqIgnoreCase": value}}
+		if negate {
+			return map[string]any{"labels": map[string]any{"every": map[string]any{
+				"name": map[string]any{"neqIgnoreCase": value},
+			}}}, nil
+		}
+		return map[string]any{"labels": map[string]any{"some": name}}, nil
+
+	case "cycle":
+		var cycle map[string]any
+		switch lower {
+		case "current", "active":
+			cycle = map[string]any{"isActive": is(true)}
+		case "next":
+			cycle = map[string]any{"isNext": is(true)}
+		case "previous", "last":
+			cycle = map[string]any{"isPrevious": is(true)}
+		case "none":
+			return map[string]any{"cycle": map[string]any{"null": !negate}}, nil
+		default:
+			number, err := strconv.Atoi(strings.TrimPrefix(value, "#"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid cycle '%s': expected current, next, previous, none or a number", value)
+			}
+			cycle = map[string]any{"number": map[string]any{eq: number}}
+		}
+		return orNull("cycle", cycle, negate), nil
+
+	case "priority":
+		priority, err := ParsePriority(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"priority": map[string]any{eq: priority}}, nil
+
+	case "title":
+		comparator := eqIgnoreCase
+		switch operator {
+		case "~":
+			comparator = "containsIgnoreCase"
+		case "!~":
+			comparator = "notContainsIgnoreCase"
+		}
+		return map[string]any{"title": map[string]any{comparator: value}}, nil
+	}
+	return nil, fmt.Errorf("unknown filter field '%s'", field)
+}
+
+// orNull returns the filter on the relation field. Negated, it also matches
+// issues without that relation, which a comparison on the relation's fields
+// never matches: assignee!=@me includes unassigned issues.
+func orNull(field string, filter map[string]any, negate bool) map[string]any {
+	if !negate {
+		return map[string]any{field: filter}
+	}
+	return map[string]any{"or": []map[string]any{
+		{field: map[string]any{"null": true}},
+		{field: filter},
+	}}
+}
+
+// nameOrKey matches an entity whose a or b field equals value, ignoring case.
+func nameOrKey(a, b, value string, negate bool) map[string]any {
+	if negate {
+		return map[string]any{
+			a: map[string]any{"neqIgnoreCase": value},
+			b: map[string]any{"neqIgnoreCase": value},
+		}
+	}
+	return map[string]any{"or": []map[string]any{
+		{a: map[string]any{"eqIgnoreCase": value}},
+		{b: map[string]any{"eqIgnoreCase": value}},
+	}}
+}
+
+// IssueQuery selects issues for FetchIssues.
+type IssueQuery struct {
+	// Filter is a Linear IssueFilter; nil matches every issue.
+	Filter map[string]any
+	// Limit caps the number of issues; 0 fetches every match.
+	Limit int
+	// Fields is the selection set, IssueFields if empty.
+	Fields string
+	// IncludeArchived also returns archived and trashed issues.
+	IncludeArchived bool
+}
+
+// FetchIssues returns the issues matching q, following pagination.
+func FetchIssues(apiKey string, q IssueQuery) ([]IssueNode, error) {
+	fields := q.Fields
+	if fields == "" {
+		fields = IssueFields
+	}
+	query := `
+	query FilterIssues($filter: IssueFilter, $first: Int, $after: String, $includeArchived: Boolean) {
+		issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived) {
+			nodes {` + fields + `}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+	`
+
+	var issues []IssueNode
+	after := ""
+	for {
+		first := 100
+		if q.Limit > 0 && q.Limit-len(issues) < first {
+			first = q.Limit - len(issues)
+		}
+		variables := map[string]any{"filter": q.Filter, "first": first, "includeArchived": q.IncludeArchived}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		data, err := api.MakeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("fetching issues: %w", err)
+		}
+		var response IssuesResponseData
+		if err := json.Unmarshal(data, &response); err != nil {
+			return nil, fmt.Errorf("unmarshalling issues: %w", err)
+		}
+
+		issues = append(issues, response.Issues.Nodes...)
+		pageInfo := response.Issues.PageInfo
+		if !pageInfo.HasNextPage || (q.Limit > 0 && len(issues) >= q.Limit) {
+			return issues, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
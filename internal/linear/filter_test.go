@@ -0,0 +1,134 @@
+package linear
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{
+			expr: `state=Todo`,
+			want: `{"state":{"name":{"eqIgnoreCase":"Todo"}}}`,
+		},
+		{
+			expr: `state=Todo,"In Progress"`,
+			want: `{"or":[{"state":{"name":{"eqIgnoreCase":"Todo"}}},{"state":{"name":{"eqIgnoreCase":"In Progress"}}}]}`,
+		},
+		{
+			expr: `state="Done, Won't fix",Canceled`,
+			want: `{"or":[{"state":{"name":{"eqIgnoreCase":"Done, Won't fix"}}},{"state":{"name":{"eqIgnoreCase":"Canceled"}}}]}`,
+		},
+		{
+			expr: `  title~"login page"   priority=high `,
+			want: `{"and":[{"title":{"containsIgnoreCase":"login page"}},{"priority":{"eq":2}}]}`,
+		},
+		{
+			expr: `state!=Done,Canceled`,
+			want: `{"and":[{"state":{"name":{"neqIgnoreCase":"Done"}}},{"state":{"name":{"neqIgnoreCase":"Canceled"}}}]}`,
+		},
+		{
+			expr: `team!=ENG`,
+			want: `{"team":{"key":{"neqIgnoreCase":"ENG"},"name":{"neqIgnoreCase":"ENG"}}}`,
+		},
+		{
+			expr: `label!=blocked`,
+			want: `{"labels":{"every":{"name":{"neqIgnoreCase":"blocked"}}}}`,
+		},
+		{
+			expr: `assignee=@me`,
+			want: `{"assignee":{"isMe":{"eq":true}}}`,
+		},
+		{
+			expr: `assignee!=@me`,
+			want: `{"or":[{"assignee":{"null":true}},{"assignee":{"isMe":{"eq":false}}}]}`,
+		},
+		{
+			expr: `assignee!=alice`,
+			want: `{"or":[{"assignee":{"null":true}},{"assignee":{"displayName":{"neqIgnoreCase":"alice"},"name":{"neqIgnoreCase":"alice"}}}]}`,
+		},
+		{
+			expr: `project!=Mobile`,
+			want: `{"or":[{"project":{"null":true}},{"project":{"name":{"neqIgnoreCase":"Mobile"}}}]}`,
+		},
+		{
+			expr: `cycle!=current`,
+			want: `{"or":[{"cycle":{"null":true}},{"cycle":{"isActive":{"eq":false}}}]}`,
+		},
+		{
+			expr: `assignee=none`,
+			want: `{"assignee":{"null":true}}`,
+		},
+		{
+			expr: `assignee!=none`,
+			want: `{"assignee":{"null":false}}`,
+		},
+		{
+			expr: `creator=alice@corp.com`,
+			want: `{"creator":{"email":{"eqIgnoreCase":"alice@corp.com"}}}`,
+		},
+		{
+			expr: `assignee="@Alice Smith"`,
+			want: `{"assignee":{"or":[{"displayName":{"eqIgnoreCase":"Alice Smith"}},{"name":{"eqIgnoreCase":"Alice Smith"}}]}}`,
+		},
+		{
+			expr: `project=none cycle=current`,
+			want: `{"and":[{"project":{"null":true}},{"cycle":{"isActive":{"eq":true}}}]}`,
+		},
+		{
+			expr: `State-Type=started`,
+			want: `{"state":{"type":{"eq":"started"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			filter, err := ParseFilter(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseFilter(%q) error: %v", tt.expr, err)
+			}
+			got, err := json.Marshal(filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ParseFilter(%q) =\n  %s\nwant\n  %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{``, "empty filter"},
+		{`state="In Progress`, "unterminated quote"},
+		{`=Todo`, `invalid filter term "=Todo": expected field=value`},
+		{`state`, `invalid filter term "state": expected field=value`},
+		{`state=`, `invalid filter term "state=": missing value`},
+		{`state=,`, `invalid filter term "state=,": missing value`},
+		{`status=Todo`, "unknown filter field 'status'"},
+		{`state~Todo`, "~ is only supported for title"},
+		{`type=doing`, "doing"},
+		{`cycle=soon`, "invalid cycle 'soon'"},
+		{`priority=whenever`, "whenever"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseFilter(tt.expr)
+			if err == nil {
+				t.Fatalf("ParseFilter(%q) succeeded, want an error", tt.expr)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseFilter(%q) error = %q, want it to contain %q", tt.expr, err, tt.want)
+			}
+		})
+	}
+}
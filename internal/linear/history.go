@@ -0,0 +1,304 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// NamedRef is the minimal shape of a related entity (user, project, label...)
+// as it appears in history entries.
+type NamedRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CycleRef identifies a cycle in history entries. Cycles are often unnamed,
+// so the number is kept as well.
+type CycleRef struct {
+	ID     string `json:"id"`
+	Number int    `json:"number"`
+	Name   string `json:"name"`
+}
+
+// Label returns a human readable name for the cycle.
+func (c *CycleRef) Label() string {
+	if c == nil {
+		return "none"
+	}
+	if c.Name != "" {
+		return c.Name
+	}
+	return fmt.Sprintf("Cycle %d", c.Number)
+}
+
+// IssueHistoryNode mirrors a single entry in the issue's history connection.
+type IssueHistoryNode struct {
+	ID            string     `json:"id"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	Actor         *NamedRef  `json:"actor"`
+	FromState     *StateNode `json:"fromState"`
+	ToState       *StateNode `json:"toState"`
+	FromAssignee  *NamedRef  `json:"fromAssignee"`
+	ToAssignee    *NamedRef  `json:"toAssignee"`
+	FromPriority  *float64   `json:"fromPriority"`
+	ToPriority    *float64   `json:"toPriority"`
+	FromEstimate  *float64   `json:"fromEstimate"`
+	ToEstimate    *float64   `json:"toEstimate"`
+	FromProject   *NamedRef  `json:"fromProject"`
+	ToProject     *NamedRef  `json:"toProject"`
+	FromCycle     *CycleRef  `json:"fromCycle"`
+	ToCycle       *CycleRef  `json:"toCycle"`
+	FromTitle     *string    `json:"fromTitle"`
+	ToTitle       *string    `json:"toTitle"`
+	AddedLabels   []NamedRef `json:"addedLabels"`
+	RemovedLabels []NamedRef `json:"removedLabels"`
+}
+
+const issueHistoryQuery = `
+query IssueHistory($id: String!, $after: String) {
+	issue(id: $id) {
+		id
+		identifier
+		title
+		createdAt
+		state {
+			id
+			name
+			type
+		}
+		history(first: 100, after: $after) {
+			nodes {
+				id
+				createdAt
+				actor { id name }
+				fromState { id name type }
+				toState { id name type }
+				fromAssignee { id name }
+				toAssignee { id name }
+				fromPriority
+				toPriority
+				fromEstimate
+				toEstimate
+				fromProject { id name }
+				toProject { id name }
+				fromCycle { id number name }
+				toCycle { id number name }
+				fromTitle
+				toTitle
+				addedLabels { id name }
+				removedLabels { id name }
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+// IssueHistory is an issue together with its full, chronologically sorted
+// history.
+type IssueHistory struct {
+	ID         string             `json:"id"`
+	Identifier string             `json:"identifier"`
+	Title      string             `json:"title"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	State      StateNode          `json:"state"`
+	Entries    []IssueHistoryNode `json:"entries"`
+}
+
+// FetchIssueHistory pages through the history connection of the issue behind
+// ref and returns the entries oldest first.
+func FetchIssueHistory(apiKey, ref, defaultTeam string) (*IssueHistory, error) {
+	id, err := NormalizeIssueRef(ref, defaultTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	var history *IssueHistory
+	var after any
+	for {
+		data, err := api.MakeGraphQLRequest(apiKey, issueHistoryQuery, map[string]any{
+			"id":    id,
+			"after": after,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fetching history for %s: %w", id, err)
+		}
+
+		var response struct {
+			Issue *struct {
+				ID         string    `json:"id"`
+				Identifier string    `json:"identifier"`
+				Title      string    `json:"title"`
+				CreatedAt  time.Time `json:"createdAt"`
+				State      StateNode `json:"state"`
+				History    struct {
+					Nodes    []IssueHistoryNode `json:"nodes"`
+					PageInfo PageInfo           `json:"pageInfo"`
+				} `json:"history"`
+			} `json:"issue"`
+		}
+		if err := json.Unmarshal(data, &response); err != nil {
+			return nil, fmt.Errorf("unmarshalling history for %s: %w", id, err)
+		}
+		if response.Issue == nil {
+			return nil, fmt.Errorf("issue %s not found", id)
+		}
+
+		if history == nil {
+			history = &IssueHistory{
+				ID:         response.Issue.ID,
+				Identifier: response.Issue.Identifier,
+				Title:      response.Issue.Title,
+				CreatedAt:  response.Issue.CreatedAt,
+				State:      response.Issue.State,
+			}
+		}
+		history.Entries = append(history.Entries, response.Issue.History.Nodes...)
+
+		if !response.Issue.History.PageInfo.HasNextPage {
+			break
+		}
+		after = response.Issue.History.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(history.Entries, func(i, j int) bool {
+		return history.Entries[i].CreatedAt.Before(history.Entries[j].CreatedAt)
+	})
+
+	return history, nil
+}
+
+// StateSpan is a period the issue spent in one workflow state.
+type StateSpan struct {
+	State    StateNode     `json:"state"`
+	Start    time.Time     `json:"start"`
+	End      *time.Time    `json:"end,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// StateSpans derives the time spent in each state from the history's state
+// transitions. The last span is open-ended and measured up to now.
+func (h *IssueHistory) StateSpans(now time.Time) []StateSpan {
+	var spans []StateSpan
+	var current *StateNode
+	start := h.CreatedAt
+
+	for _, entry := range h.Entries {
+		if entry.ToState == nil {
+			continue
+		}
+		if current == nil && entry.FromState != nil {
+			current = entry.FromState
+		}
+		if current != nil {
+			end := entry.CreatedAt
+			spans = append(spans, StateSpan{
+				State:    *current,
+				Start:    start,
+				End:      &end,
+				Duration: end.Sub(start),
+			})
+		}
+		current = entry.ToState
+		start = entry.CreatedAt
+	}
+
+	if current == nil {
+		current = &h.State
+	}
+	spans = append(spans, StateSpan{
+		State:    *current,
+		Start:    start,
+		Duration: now.Sub(start),
+	})
+
+	return spans
+}
+
+// Changes describes every field change in the entry as short sentences,
+// e.g. "state: Todo -> In Progress".
+func (e IssueHistoryNode) Changes() []string {
+	var changes []string
+
+	if e.FromState != nil || e.ToState != nil {
+		changes = append(changes, fmt.Sprintf("state: %s -> %s", stateName(e.FromState), stateName(e.ToState)))
+	}
+	if e.FromAssignee != nil || e.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("assignee: %s -> %s", refName(e.FromAssignee, "unassigned"), refName(e.ToAssignee, "unassigned")))
+	}
+	if e.FromPriority != nil || e.ToPriority != nil {
+		if !floatEqual(e.FromPriority, e.ToPriority) {
+			changes = append(changes, fmt.Sprintf("priority: %s -> %s", priorityName(e.FromPriority), priorityName(e.ToPriority)))
+		}
+	}
+	if e.FromEstimate != nil || e.ToEstimate != nil {
+		if !floatEqual(e.FromEstimate, e.ToEstimate) {
+			changes = append(changes, fmt.Sprintf("estimate: %s -> %s", floatString(e.FromEstimate), floatString(e.ToEstimate)))
+		}
+	}
+	if e.FromProject != nil || e.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("project: %s -> %s", refName(e.FromProject, "none"), refName(e.ToProject, "none")))
+	}
+	if e.FromCycle != nil || e.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("cycle: %s -> %s", e.FromCycle.Label(), e.ToCycle.Label()))
+	}
+	if e.FromTitle != nil && e.ToTitle != nil && *e.FromTitle != *e.ToTitle {
+		changes = append(changes, fmt.Sprintf("title: %q -> %q", *e.FromTitle, *e.ToTitle))
+	}
+	for _, label := range e.AddedLabels {
+		changes = append(changes, "label added: "+label.Name)
+	}
+	for _, label := range e.RemovedLabels {
+		changes = append(changes, "label removed: "+label.Name)
+	}
+
+	return changes
+}
+
+// ActorName returns who made the change, or "Linear" for automated changes.
+func (e IssueHistoryNode) ActorName() string {
+	return refName(e.Actor, "Linear")
+}
+
+func stateName(s *StateNode) string {
+	if s == nil {
+		return "none"
+	}
+	return s.Name
+}
+
+func refName(r *NamedRef, fallback string) string {
+	if r == nil || r.Name == "" {
+		return fallback
+	}
+	return r.Name
+}
+
+func floatEqual(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func floatString(f *float64) string {
+	if f == nil {
+		return "none"
+	}
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", *f), "0"), ".")
+}
+
+func priorityName(p *float64) string {
+	if p == nil {
+		return PriorityName(PriorityNone)
+	}
+	return PriorityName(int(*p))
+}
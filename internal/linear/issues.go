@@ -0,0 +1,133 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// IssueCreateInput mirrors Linear's IssueCreateInput. Empty fields are left
+// out so the API applies the team defaults.
+type IssueCreateInput struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	TeamID      string   `json:"teamId"`
+	ProjectID   string   `json:"projectId,omitempty"`
+	AssigneeID  string   `json:"assigneeId,omitempty"`
+	StateID     string   `json:"stateId,omitempty"`
+	LabelIDs    []string `json:"labelIds,omitempty"`
+	Priority    *int     `json:"priority,omitempty"`
+	Estimate    *int     `json:"estimate,omitempty"`
+	DueDate     string   `json:"dueDate,omitempty"`
+	CycleID     string   `json:"cycleId,omitempty"`
+	ParentID    string   `json:"parentId,omitempty"`
+}
+
+// CreateIssue runs the issueCreate mutation and returns the new issue.
+func CreateIssue(apiKey string, input IssueCreateInput) (*IssueNode, error) {
+	mutation := `
+	mutation CreateIssue($input: IssueCreateInput!) {
+		issueCreate(input: $input) {
+			success
+			issue {` + IssueFields + `}
+		}
+	}
+	`
+
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("creating issue: %w", err)
+	}
+
+	var response IssueCreateResponseData
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling create issue response: %w", err)
+	}
+	if !response.IssueCreate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+
+	return &response.IssueCreate.Issue, nil
+}
+
+// UpdateIssue runs the issueUpdate mutation with the given IssueUpdateInput
+// fields and returns the updated issue. Only the keys present in input are
+// changed; a nil value clears the field.
+func UpdateIssue(apiKey, id string, input map[string]any) (*IssueNode, error) {
+	mutation := `
+	mutation UpdateIssue($id: String!, $input: IssueUpdateInput!) {
+		issueUpdate(id: $id, input: $input) {
+			success
+			issue {` + IssueFields + `}
+		}
+	}
+	`
+
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{
+		"id":    id,
+		"input": input,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating issue: %w", err)
+	}
+
+	var response struct {
+		IssueUpdate struct {
+			Success bool      `json:"success"`
+			Issue   IssueNode `json:"issue"`
+		} `json:"issueUpdate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling update issue response: %w", err)
+	}
+	if !response.IssueUpdate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+
+	return &response.IssueUpdate.Issue, nil
+}
+
+// DeleteIssue moves an issue to the trash, from where it can be restored
+// with UnarchiveIssue.
+func DeleteIssue(apiKey, id string) error {
+	return issueMutation(apiKey, "issueDelete", id)
+}
+
+// ArchiveIssue archives an issue.
+func ArchiveIssue(apiKey, id string) error {
+	return issueMutation(apiKey, "issueArchive", id)
+}
+
+// UnarchiveIssue unarchives an archived issue or restores a trashed one.
+func UnarchiveIssue(apiKey, id string) error {
+	return issueMutation(apiKey, "issueUnarchive", id)
+}
+
+// issueMutation runs a mutation that takes an issue ID and returns only
+// success, such as issueArchive.
+func issueMutation(apiKey, name, id string) error {
+	mutation := `
+	mutation IssueMutation($id: String!) {
+		` + name + `(id: $id) {
+			success
+		}
+	}
+	`
+
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	var response map[string]struct {
+		Success bool `json:"success"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling %s response: %w", name, err)
+	}
+	if !response[name].Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
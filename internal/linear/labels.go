@@ -0,0 +1,238 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// LabelFields is the selection set used whenever labels are fetched.
+const LabelFields = `
+	id
+	name
+	color
+	isGroup
+	parent { id name }
+	team { id name }
+`
+
+// Labels is a list of workspace and team labels.
+type Labels []LabelNode
+
+// Find returns the label with the given ID or name, ignoring case. A name
+// may be qualified with its group as "Group/Name". Labels of team teamID
+// take precedence over workspace labels of the same name; without a team, a
+// name used by several teams is ambiguous.
+func (l Labels) Find(nameOrID, teamID string) (*LabelNode, error) {
+	var matches []int
+	for i, label := range l {
+		if label.ID == nameOrID || strings.EqualFold(label.Name, nameOrID) {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		if group, name, ok := strings.Cut(nameOrID, "/"); ok {
+			for i, label := range l {
+				if label.Parent != nil && strings.EqualFold(label.Parent.Name, group) && strings.EqualFold(label.Name, name) {
+					matches = append(matches, i)
+				}
+			}
+		}
+	}
+
+	var workspace, team, other []int
+	for _, i := range matches {
+		switch {
+		case l[i].Team == nil:
+			workspace = append(workspace, i)
+		case l[i].Team.ID == teamID:
+			team = append(team, i)
+		default:
+			other = append(other, i)
+		}
+	}
+	switch {
+	case len(team) > 0:
+		return &l[team[0]], nil
+	case len(workspace) > 0:
+		return &l[workspace[0]], nil
+	case len(other) == 1:
+		return &l[other[0]], nil
+	case len(other) > 1:
+		teams := make([]string, len(other))
+		for n, i := range other {
+			teams[n] = l[i].Team.Name
+		}
+		return nil, fmt.Errorf("label %q exists in several teams (%s); specify the team", nameOrID, strings.Join(teams, ", "))
+	}
+	return nil, fmt.Errorf("label %q not found", nameOrID)
+}
+
+// Path returns the label name qualified with its group, e.g. "Type/Bug".
+func (l LabelNode) Path() string {
+	if l.Parent != nil {
+		return l.Parent.Name + "/" + l.Name
+	}
+	return l.Name
+}
+
+// FetchLabels returns the workspace labels and those of the given team, or
+// of every team if teamID is empty, sorted by path.
+func FetchLabels(apiKey, teamID string) (Labels, error) {
+	query := `
+	query Labels($filter: IssueLabelFilter, $after: String) {
+		issueLabels(first: 250, after: $after, filter: $filter) {
+			nodes {` + LabelFields + `}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+	`
+	var filter map[string]any
+	if teamID != "" {
+		filter = map[string]any{"or": []any{
+			map[string]any{"team": map[string]any{"id": map[string]any{"eq": teamID}}},
+			map[string]any{"team": map[string]any{"null": true}},
+		}}
+	}
+
+	var labels Labels
+	after := ""
+	for {
+		variables := map[string]any{"filter": filter}
+		if after != "" {
+			variables["after"] = after
+		}
+		data, err := api.MakeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("fetching labels: %w", err)
+		}
+
+		var response struct {
+			IssueLabels struct {
+				Nodes    []LabelNode `json:"nodes"`
+				PageInfo PageInfo    `json:"pageInfo"`
+			} `json:"issueLabels"`
+		}
+		if err := json.Unmarshal(data, &response); err != nil {
+			return nil, fmt.Errorf("unmarshalling labels: %w", err)
+		}
+		labels = append(labels, response.IssueLabels.Nodes...)
+		page := response.IssueLabels.PageInfo
+		if !page.HasNextPage || page.EndCursor == "" {
+			break
+		}
+		after = page.EndCursor
+	}
+
+	sort.SliceStable(labels, func(i, j int) bool {
+		return strings.ToLower(labels[i].Path()) < strings.ToLower(labels[j].Path())
+	})
+	return labels, nil
+}
+
+// LabelCreateInput is the IssueLabelCreateInput of a new label. Without a
+// TeamID the label is created at workspace level.
+type LabelCreateInput struct {
+	Name     string `json:"name"`
+	Color    string `json:"color,omitempty"`
+	TeamID   string `json:"teamId,omitempty"`
+	ParentID string `json:"parentId,omitempty"`
+	IsGroup  bool   `json:"isGroup,omitempty"`
+}
+
+// CreateLabel creates an issue label.
+func CreateLabel(apiKey string, input LabelCreateInput) (*LabelNode, error) {
+	mutation := `
+	mutation CreateLabel($input: IssueLabelCreateInput!) {
+		issueLabelCreate(input: $input) {
+			success
+			issueLabel {` + LabelFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("creating label: %w", err)
+	}
+
+	var response struct {
+		IssueLabelCreate struct {
+			Success    bool      `json:"success"`
+			IssueLabel LabelNode `json:"issueLabel"`
+		} `json:"issueLabelCreate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling label response: %w", err)
+	}
+	if !response.IssueLabelCreate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.IssueLabelCreate.IssueLabel, nil
+}
+
+// RenameLabel changes the name of a label.
+func RenameLabel(apiKey, id, name string) (*LabelNode, error) {
+	mutation := `
+	mutation RenameLabel($id: String!, $input: IssueLabelUpdateInput!) {
+		issueLabelUpdate(id: $id, input: $input) {
+			success
+			issueLabel {` + LabelFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{
+		"id":    id,
+		"input": map[string]any{"name": name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("renaming label: %w", err)
+	}
+
+	var response struct {
+		IssueLabelUpdate struct {
+			Success    bool      `json:"success"`
+			IssueLabel LabelNode `json:"issueLabel"`
+		} `json:"issueLabelUpdate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling label response: %w", err)
+	}
+	if !response.IssueLabelUpdate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.IssueLabelUpdate.IssueLabel, nil
+}
+
+// DeleteLabel deletes a label, removing it from every issue.
+func DeleteLabel(apiKey, id string) error {
+	mutation := `
+	mutation DeleteLabel($id: String!) {
+		issueLabelDelete(id: $id) {
+			success
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("deleting label: %w", err)
+	}
+
+	var response struct {
+		IssueLabelDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueLabelDelete"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling delete label response: %w", err)
+	}
+	if !response.IssueLabelDelete.Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
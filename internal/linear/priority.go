@@ -0,0 +1,49 @@
+package linear
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Priority levels as used by the Linear API.
+const (
+	PriorityNone   = 0
+	PriorityUrgent = 1
+	PriorityHigh   = 2
+	PriorityMedium = 3
+	PriorityLow    = 4
+)
+
+// PriorityNames lists the named levels in the order Linear shows them.
+var PriorityNames = []string{"No priority", "Urgent", "High", "Medium", "Low"}
+
+// PriorityName returns the display name of a priority value.
+func PriorityName(p int) string {
+	if p < 0 || p >= len(PriorityNames) {
+		return PriorityNames[PriorityNone]
+	}
+	return PriorityNames[p]
+}
+
+// ParsePriority accepts a level name (urgent, high, medium, low, none) or its
+// numeric value (0-4).
+func ParsePriority(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "none", "no priority", "no-priority":
+		return PriorityNone, nil
+	case "urgent":
+		return PriorityUrgent, nil
+	case "high":
+		return PriorityHigh, nil
+	case "medium", "normal":
+		return PriorityMedium, nil
+	case "low":
+		return PriorityLow, nil
+	}
+	if n, err := strconv.Atoi(value); err == nil && n >= PriorityNone && n <= PriorityLow {
+		return n, nil
+	}
+	return 0, fmt.Errorf("invalid priority %q (expected urgent, high, medium, low, none or 0-4)", value)
+}
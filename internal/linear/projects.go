@@ -0,0 +1,404 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// ProjectFields is the selection set used whenever projects are fetched.
+const ProjectFields = `
+	id
+	name
+	description
+	url
+	status { id name type position }
+	progress
+	startDate
+	targetDate
+	lead { id name displayName email }
+	teams { nodes { id key name } }
+	archivedAt
+`
+
+// ProjectStatusNode is a workspace project status such as "In Progress".
+// Type is one of backlog, planned, started, paused, completed or canceled.
+type ProjectStatusNode struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Position float64 `json:"position"`
+}
+
+// ProjectMilestoneNode is a milestone of a project.
+type ProjectMilestoneNode struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	TargetDate  string  `json:"targetDate,omitempty"`
+	SortOrder   float64 `json:"sortOrder"`
+}
+
+// ProjectUpdateNode is a status update posted on a project. Health is one
+// of onTrack, atRisk or offTrack.
+type ProjectUpdateNode struct {
+	ID        string    `json:"id"`
+	Body      string    `json:"body"`
+	Health    string    `json:"health"`
+	CreatedAt time.Time `json:"createdAt"`
+	User      *UserNode `json:"user,omitempty"`
+}
+
+// ProjectDetails is a project with its milestones and latest updates.
+type ProjectDetails struct {
+	ProjectNode
+	Milestones []ProjectMilestoneNode `json:"milestones"`
+	Updates    []ProjectUpdateNode    `json:"updates"`
+}
+
+// TeamIDs returns the IDs of the project's teams.
+func (p ProjectNode) TeamIDs() []string {
+	if p.Teams == nil {
+		return nil
+	}
+	ids := make([]string, len(p.Teams.Nodes))
+	for i, team := range p.Teams.Nodes {
+		ids[i] = team.ID
+	}
+	return ids
+}
+
+// StatusName returns the name of the project's status, or "".
+func (p ProjectNode) StatusName() string {
+	if p.Status == nil {
+		return ""
+	}
+	return p.Status.Name
+}
+
+// LeadName returns the name of the project's lead, or "".
+func (p ProjectNode) LeadName() string {
+	if p.Lead == nil {
+		return ""
+	}
+	return p.Lead.Name
+}
+
+// FetchProjects returns the projects of a team, or of every team if teamID
+// is empty, sorted by name.
+func FetchProjects(apiKey, teamID string, includeArchived bool) ([]ProjectNode, error) {
+	query := `
+	query Projects($filter: ProjectFilter, $after: String, $includeArchived: Boolean) {
+		projects(first: 100, after: $after, filter: $filter, includeArchived: $includeArchived) {
+			nodes {` + ProjectFields + `}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+	`
+	var filter map[string]any
+	if teamID != "" {
+		filter = map[string]any{"accessibleTeams": map[string]any{"some": map[string]any{"id": map[string]any{"eq": teamID}}}}
+	}
+
+	var projects []ProjectNode
+	after := ""
+	for {
+		variables := map[string]any{"filter": filter, "includeArchived": includeArchived}
+		if after != "" {
+			variables["after"] = after
+		}
+		data, err := api.MakeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("fetching projects: %w", err)
+		}
+
+		var response struct {
+			Projects struct {
+				Nodes    []ProjectNode `json:"nodes"`
+				PageInfo PageInfo      `json:"pageInfo"`
+			} `json:"projects"`
+		}
+		if err := json.Unmarshal(data, &response); err != nil {
+			return nil, fmt.Errorf("unmarshalling projects: %w", err)
+		}
+		projects = append(projects, response.Projects.Nodes...)
+		page := response.Projects.PageInfo
+		if !page.HasNextPage || page.EndCursor == "" {
+			break
+		}
+		after = page.EndCursor
+	}
+
+	sort.SliceStable(projects, func(i, j int) bool {
+		return strings.ToLower(projects[i].Name) < strings.ToLower(projects[j].Name)
+	})
+	return projects, nil
+}
+
+// FindProject returns the project with the given ID or name, ignoring case.
+// A name may also be a part of a single project's name.
+func FindProject(projects []ProjectNode, nameOrID string) (*ProjectNode, error) {
+	var partial []int
+	for i, project := range projects {
+		if project.ID == nameOrID || strings.EqualFold(project.Name, nameOrID) {
+			return &projects[i], nil
+		}
+		if strings.Contains(strings.ToLower(project.Name), strings.ToLower(nameOrID)) {
+			partial = append(partial, i)
+		}
+	}
+	switch len(partial) {
+	case 0:
+		return nil, fmt.Errorf("project %q not found", nameOrID)
+	case 1:
+		return &projects[partial[0]], nil
+	}
+	names := make([]string, len(partial))
+	for n, i := range partial {
+		names[n] = projects[i].Name
+	}
+	return nil, fmt.Errorf("%q matches several projects: %s", nameOrID, strings.Join(names, ", "))
+}
+
+// FetchProjectDetails returns a project with its milestones and its latest
+// updates, at most updates of them.
+func FetchProjectDetails(apiKey, id string, updates int) (*ProjectDetails, error) {
+	query := `
+	query ProjectDetails($id: String!, $updates: Int) {
+		project(id: $id) {` + ProjectFields + `
+			projectMilestones(first: 100) {
+				nodes { id name description targetDate sortOrder }
+			}
+			projectUpdates(first: $updates) {
+				nodes {
+					id
+					body
+					health
+					createdAt
+					user { id name displayName }
+				}
+			}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, map[string]any{"id": id, "updates": max(updates, 1)})
+	if err != nil {
+		return nil, fmt.Errorf("fetching project: %w", err)
+	}
+
+	var response struct {
+		Project *struct {
+			ProjectNode
+			ProjectMilestones struct {
+				Nodes []ProjectMilestoneNode `json:"nodes"`
+			} `json:"projectMilestones"`
+			ProjectUpdates struct {
+				Nodes []ProjectUpdateNode `json:"nodes"`
+			} `json:"projectUpdates"`
+		} `json:"project"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling project: %w", err)
+	}
+	if response.Project == nil {
+		return nil, fmt.Errorf("project %s not found", id)
+	}
+
+	details := &ProjectDetails{
+		ProjectNode: response.Project.ProjectNode,
+		Milestones:  response.Project.ProjectMilestones.Nodes,
+		Updates:     response.Project.ProjectUpdates.Nodes,
+	}
+	if updates <= 0 {
+		details.Updates = nil
+	}
+	sort.SliceStable(details.Milestones, func(i, j int) bool {
+		return details.Milestones[i].SortOrder < details.Milestones[j].SortOrder
+	})
+	sort.SliceStable(details.Updates, func(i, j int) bool {
+		return details.Updates[i].CreatedAt.After(details.Updates[j].CreatedAt)
+	})
+	return details, nil
+}
+
+// FetchProjectStatuses returns the workspace's project statuses in order.
+func FetchProjectStatuses(apiKey string) ([]ProjectStatusNode, error) {
+	query := `
+	query ProjectStatuses {
+		projectStatuses {
+			nodes { id name type position }
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching project statuses: %w", err)
+	}
+
+	var response struct {
+		ProjectStatuses struct {
+			Nodes []ProjectStatusNode `json:"nodes"`
+		} `json:"projectStatuses"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling project statuses: %w", err)
+	}
+	statuses := response.ProjectStatuses.Nodes
+	sort.SliceStable(statuses, func(i, j int) bool { return statuses[i].Position < statuses[j].Position })
+	return statuses, nil
+}
+
+// FindProjectStatus returns the status with the given name, ID or type,
+// ignoring case.
+func FindProjectStatus(statuses []ProjectStatusNode, name string) (*ProjectStatusNode, error) {
+	for i, status := range statuses {
+		if status.ID == name || strings.EqualFold(status.Name, name) {
+			return &statuses[i], nil
+		}
+	}
+	for i, status := range statuses {
+		if strings.EqualFold(status.Type, name) {
+			return &statuses[i], nil
+		}
+	}
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = status.Name
+	}
+	return nil, fmt.Errorf("project status %q not found (expected one of %s)", name, strings.Join(names, ", "))
+}
+
+// ProjectCreateInput is the ProjectCreateInput of a new project.
+type ProjectCreateInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	TeamIDs     []string `json:"teamIds"`
+	LeadID      string   `json:"leadId,omitempty"`
+	StatusID    string   `json:"statusId,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	TargetDate  string   `json:"targetDate,omitempty"`
+}
+
+// CreateProject creates a project.
+func CreateProject(apiKey string, input ProjectCreateInput) (*ProjectNode, error) {
+	mutation := `
+	mutation CreateProject($input: ProjectCreateInput!) {
+		projectCreate(input: $input) {
+			success
+			project {` + ProjectFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("creating project: %w", err)
+	}
+
+	var response struct {
+		ProjectCreate struct {
+			Success bool        `json:"success"`
+			Project ProjectNode `json:"project"`
+		} `json:"projectCreate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling project response: %w", err)
+	}
+	if !response.ProjectCreate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.ProjectCreate.Project, nil
+}
+
+// UpdateProject applies a ProjectUpdateInput, given as a map so that fields
+// can be cleared with nil.
+func UpdateProject(apiKey, id string, input map[string]any) (*ProjectNode, error) {
+	mutation := `
+	mutation UpdateProject($id: String!, $input: ProjectUpdateInput!) {
+		projectUpdate(id: $id, input: $input) {
+			success
+			project {` + ProjectFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id, "input": input})
+	if err != nil {
+		return nil, fmt.Errorf("updating project: %w", err)
+	}
+
+	var response struct {
+		ProjectUpdate struct {
+			Success bool        `json:"success"`
+			Project ProjectNode `json:"project"`
+		} `json:"projectUpdate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling project response: %w", err)
+	}
+	if !response.ProjectUpdate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.ProjectUpdate.Project, nil
+}
+
+// ArchiveProject archives a project.
+func ArchiveProject(apiKey, id string) error {
+	mutation := `
+	mutation ArchiveProject($id: String!) {
+		projectArchive(id: $id) {
+			success
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("archiving project: %w", err)
+	}
+
+	var response struct {
+		ProjectArchive struct {
+			Success bool `json:"success"`
+		} `json:"projectArchive"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling archive project response: %w", err)
+	}
+	if !response.ProjectArchive.Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
+
+// UnarchiveProject restores an archived project.
+func UnarchiveProject(apiKey, id string) error {
+	mutation := `
+	mutation UnarchiveProject($id: String!) {
+		projectUnarchive(id: $id) {
+			success
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("unarchiving project: %w", err)
+	}
+
+	var response struct {
+		ProjectUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"projectUnarchive"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling unarchive project response: %w", err)
+	}
+	if !response.ProjectUnarchive.Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
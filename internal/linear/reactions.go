@@ -0,0 +1,117 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// IssueReactionFields selects an issue's reactions. It is appended to
+// IssueFields where reactions are shown.
+const IssueReactionFields = `
+	reactions {
+		id
+		emoji
+		user { id name }
+	}
+`
+
+// ReactionNode is an emoji reaction on an issue or comment. Emoji is the
+// shortcode name, e.g. "+1".
+type ReactionNode struct {
+	ID    string    `json:"id"`
+	Emoji string    `json:"emoji"`
+	User  *UserNode `json:"user,omitempty"`
+}
+
+// ReactionCount is the number of reactions with one emoji.
+type ReactionCount struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// CountReactions aggregates reactions by emoji, in order of first use.
+func CountReactions(reactions []ReactionNode) []ReactionCount {
+	var counts []ReactionCount
+	index := map[string]int{}
+	for _, reaction := range reactions {
+		i, ok := index[reaction.Emoji]
+		if !ok {
+			i = len(counts)
+			index[reaction.Emoji] = i
+			counts = append(counts, ReactionCount{Emoji: reaction.Emoji})
+		}
+		counts[i].Count++
+	}
+	return counts
+}
+
+// ReactionCreateInput is the ReactionCreateInput of a new reaction on
+// either an issue or a comment.
+type ReactionCreateInput struct {
+	Emoji     string `json:"emoji"`
+	IssueID   string `json:"issueId,omitempty"`
+	CommentID string `json:"commentId,omitempty"`
+}
+
+// CreateReaction adds an emoji reaction.
+func CreateReaction(apiKey string, input ReactionCreateInput) (*ReactionNode, error) {
+	mutation := `
+	mutation CreateReaction($input: ReactionCreateInput!) {
+		reactionCreate(input: $input) {
+			success
+			reaction {
+				id
+				emoji
+			}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("creating reaction: %w", err)
+	}
+
+	var response struct {
+		ReactionCreate struct {
+			Success  bool         `json:"success"`
+			Reaction ReactionNode `json:"reaction"`
+		} `json:"reactionCreate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling reaction response: %w", err)
+	}
+	if !response.ReactionCreate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.ReactionCreate.Reaction, nil
+}
+
+// DeleteReaction removes a reaction.
+func DeleteReaction(apiKey, id string) error {
+	mutation := `
+	mutation DeleteReaction($id: String!) {
+		reactionDelete(id: $id) {
+			success
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("deleting reaction: %w", err)
+	}
+
+	var response struct {
+		ReactionDelete struct {
+			Success bool `json:"success"`
+		} `json:"reactionDelete"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling delete reaction response: %w", err)
+	}
+	if !response.ReactionDelete.Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// IssueRelationFields selects an issue's relations in both directions. It is
+// appended to IssueFields where relations are shown.
+const IssueRelationFields = `
+	relations {
+		nodes {
+			id
+			type
+			relatedIssue { id identifier title state { name type } }
+		}
+	}
+	inverseRelations {
+		nodes {
+			id
+			type
+			issue { id identifier title state { name type } }
+		}
+	}
+`
+
+// IssueChildrenFields selects an issue's sub-issues and the state of its
+// parent. It is appended to IssueFields where the hierarchy is shown.
+const IssueChildrenFields = `
+	parent { state { name type } }
+	children { nodes { id identifier title state { name type } } }
+`
+
+// RelatedIssue is the other side of a relation.
+type RelatedIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+}
+
+// IssueRelationNode is a relation as returned by the API: Issue <Type>
+// RelatedIssue, e.g. ENG-1 blocks ENG-2.
+type IssueRelationNode struct {
+	ID           string        `json:"id"`
+	Type         string        `json:"type"`
+	Issue        *RelatedIssue `json:"issue,omitempty"`
+	RelatedIssue *RelatedIssue `json:"relatedIssue,omitempty"`
+}
+
+type RelationConnection struct {
+	Nodes []IssueRelationNode `json:"nodes"`
+}
+
+// Relation is a relation seen from one issue.
+type Relation struct {
+	ID string `json:"id"`
+	// Kind reads from the issue's point of view: blocks, blocked by,
+	// duplicates, duplicated by, related or similar.
+	Kind string `json:"kind"`
+	Type string `json:"type"`
+	// Inverse is set when the other issue is the relation's subject, as in
+	// "blocked by".
+	Inverse bool         `json:"inverse"`
+	Issue   RelatedIssue `json:"issue"`
+}
+
+// inverseKinds names relation types seen from the related issue.
+var inverseKinds = map[string]string{
+	"blocks":    "blocked by",
+	"duplicate": "duplicated by",
+}
+
+// IssueRelations returns the issue's relations in both directions. It is
+// empty unless the issue was fetched with IssueRelationFields.
+func (i IssueNode) IssueRelations() []Relation {
+	var relations []Relation
+	if i.Relations != nil {
+		for _, node := range i.Relations.Nodes {
+			if node.RelatedIssue == nil {
+				continue
+			}
+			kind := node.Type
+			if kind == "duplicate" {
+				kind = "duplicates"
+			}
+			relations = append(relations, Relation{ID: node.ID, Kind: kind, Type: node.Type, Issue: *node.RelatedIssue})
+		}
+	}
+	if i.InverseRelations != nil {
+		for _, node := range i.InverseRelations.Nodes {
+			if node.Issue == nil {
+				continue
+			}
+			kind, ok := inverseKinds[node.Type]
+			if !ok {
+				kind = node.Type
+			}
+			relations = append(relations, Relation{ID: node.ID, Kind: kind, Type: node.Type, Inverse: true, Issue: *node.Issue})
+		}
+	}
+	return relations
+}
+
+// ParseRelationVerb maps a verb such as "blocks" or "blocked-by" to the API
+// relation type. inverse means the two issues must be swapped.
+func ParseRelationVerb(verb string) (relationType string, inverse bool, err error) {
+	switch strings.ToLower(strings.ReplaceAll(verb, "_", "-")) {
+	case "blocks":
+		return "blocks", false, nil
+	case "blocked-by":
+		return "blocks", true, nil
+	case "duplicates", "duplicate-of":
+		return "duplicate", false, nil
+	case "duplicated-by":
+		return "duplicate", true, nil
+	case "related", "relates", "relates-to", "related-to":
+		return "related", false, nil
+	}
+	return "", false, fmt.Errorf("unknown relation %q (expected blocks, blocked-by, duplicates, duplicate-of, duplicated-by or related)", verb)
+}
+
+// CreateIssueRelation records that issueID <relationType> relatedIssueID.
+func CreateIssueRelation(apiKey, issueID, relatedIssueID, relationType string) (*IssueRelationNode, error) {
+	mutation := `
+	mutation CreateIssueRelation($input: IssueRelationCreateInput!) {
+		issueRelationCreate(input: $input) {
+			success
+			issueRelation { id type }
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{
+		"input": map[string]any{
+			"issueId":        issueID,
+			"relatedIssueId": relatedIssueID,
+			"type":           relationType,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating relation: %w", err)
+	}
+
+	var response struct {
+		IssueRelationCreate struct {
+			Success       bool              `json:"success"`
+			IssueRelation IssueRelationNode `json:"issueRelation"`
+		} `json:"issueRelationCreate"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling relation response: %w", err)
+	}
+	if !response.IssueRelationCreate.Success {
+		return nil, fmt.Errorf("API reported success: false")
+	}
+	return &response.IssueRelationCreate.IssueRelation, nil
+}
+
+// DeleteIssueRelation removes a relation.
+func DeleteIssueRelation(apiKey, id string) error {
+	mutation := `
+	mutation DeleteIssueRelation($id: String!) {
+		issueRelationDelete(id: $id) {
+			success
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, mutation, map[string]any{"id": id})
+	if err != nil {
+		return fmt.Errorf("deleting relation: %w", err)
+	}
+
+	var response struct {
+		IssueRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueRelationDelete"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("unmarshalling delete relation response: %w", err)
+	}
+	if !response.IssueRelationDelete.Success {
+		return fmt.Errorf("API reported success: false")
+	}
+	return nil
+}
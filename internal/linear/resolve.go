@@ -0,0 +1,159 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// IssueFields is the selection set used whenever a single issue is fetched.
+const IssueFields = `
+	id
+	identifier
+	title
+	description
+	url
+	priority
+	priorityLabel
+	estimate
+	dueDate
+	createdAt
+	updatedAt
+	archivedAt
+	trashed
+	state {
+		id
+		name
+		type
+	}
+	team {
+		id
+		key
+		name
+	}
+	project {
+		id
+		name
+	}
+	assignee {
+		id
+		name
+	}
+	creator {
+		id
+		name
+	}
+	cycle {
+		id
+		number
+		name
+	}
+	parent {
+		id
+		identifier
+		title
+	}
+	labels {
+		nodes {
+			id
+			name
+			color
+		}
+	}
+`
+
+var (
+	uuidPattern       = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	identifierPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)-([0-9]+)$`)
+	numberPattern     = regexp.MustCompile(`^#?([0-9]+)$`)
+)
+
+// NormalizeIssueRef turns any supported issue reference into a value the
+// `issue(id:)` query accepts: either a UUID or an upper-cased identifier.
+//
+// Supported forms are ENG-123, eng-123, a bare number such as 123 (which
+// needs defaultTeam to be the team key), a Linear issue URL such as
+// https://linear.app/acme/issue/ENG-123/some-slug, and an issue UUID.
+func NormalizeIssueRef(ref, defaultTeam string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", fmt.Errorf("empty issue reference")
+	}
+
+	if strings.Contains(ref, "://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return "", fmt.Errorf("invalid issue URL %q: %w", ref, err)
+		}
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		for i, segment := range segments {
+			if segment == "issue" && i+1 < len(segments) {
+				return NormalizeIssueRef(segments[i+1], defaultTeam)
+			}
+		}
+		return "", fmt.Errorf("URL %q does not point to a Linear issue", ref)
+	}
+
+	if uuidPattern.MatchString(ref) {
+		return strings.ToLower(ref), nil
+	}
+
+	if m := identifierPattern.FindStringSubmatch(ref); m != nil {
+		return strings.ToUpper(m[1]) + "-" + m[2], nil
+	}
+
+	if m := numberPattern.FindStringSubmatch(ref); m != nil {
+		if defaultTeam == "" {
+			return "", fmt.Errorf(
+				"issue number %q needs a team key; set DEFAULT_TEAM or use the full identifier",
+				ref,
+			)
+		}
+		n, _ := strconv.Atoi(m[1])
+		return fmt.Sprintf("%s-%d", strings.ToUpper(defaultTeam), n), nil
+	}
+
+	return "", fmt.Errorf("unrecognised issue reference %q", ref)
+}
+
+// ResolveIssue fetches the issue behind ref using a single query.
+func ResolveIssue(apiKey, ref, defaultTeam string) (*IssueNode, error) {
+	return ResolveIssueFields(apiKey, ref, defaultTeam, IssueFields)
+}
+
+// ResolveIssueFields is ResolveIssue with a custom selection set, e.g.
+// IssueFields + IssueRelationFields.
+func ResolveIssueFields(apiKey, ref, defaultTeam, fields string) (*IssueNode, error) {
+	id, err := NormalizeIssueRef(ref, defaultTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+	query ResolveIssue($id: String!) {
+		issue(id: $id) {` + fields + `}
+	}
+	`
+
+	data, err := api.MakeGraphQLRequest(apiKey, query, map[string]any{"id": id})
+	if err != nil {
+		return nil, fmt.Errorf("fetching issue %s: %w", id, err)
+	}
+
+	var response struct {
+		Issue *IssueNode `json:"issue"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling issue %s: %w", id, err)
+	}
+	if response.Issue == nil {
+		return nil, fmt.Errorf("issue %s not found", id)
+	}
+
+	return response.Issue, nil
+}
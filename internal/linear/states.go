@@ -0,0 +1,91 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// WorkflowStates are the workflow states of a team. They translate between
+// state names, types and IDs.
+type WorkflowStates []StateNode
+
+// Find returns the state with the given name or ID.
+func (w WorkflowStates) Find(nameOrID string) (*StateNode, bool) {
+	for i, state := range w {
+		if state.ID == nameOrID || strings.EqualFold(state.Name, nameOrID) {
+			return &w[i], true
+		}
+	}
+	return nil, false
+}
+
+// OfType returns the states of the given type in workflow order.
+func (w WorkflowStates) OfType(stateType string) []StateNode {
+	var states []StateNode
+	for _, state := range w {
+		if state.Type == stateType {
+			states = append(states, state)
+		}
+	}
+	slices.SortStableFunc(states, func(a, b StateNode) int {
+		switch {
+		case a.Position < b.Position:
+			return -1
+		case a.Position > b.Position:
+			return 1
+		}
+		return 0
+	})
+	return states
+}
+
+// IDsOfTypes returns the IDs of the states whose type is one of types.
+func (w WorkflowStates) IDsOfTypes(types []string) []string {
+	var ids []string
+	for _, state := range w {
+		if slices.Contains(types, state.Type) {
+			ids = append(ids, state.ID)
+		}
+	}
+	return ids
+}
+
+// FetchWorkflowStates returns the workflow states of a team.
+func FetchWorkflowStates(apiKey, teamID string) (WorkflowStates, error) {
+	query := `
+	query TeamStates($teamId: String!) {
+		team(id: $teamId) {
+			id
+			name
+			states { nodes { id name type position } }
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, map[string]any{"teamId": teamID})
+	if err != nil {
+		return nil, fmt.Errorf("fetching workflow states: %w", err)
+	}
+
+	var response TeamStatesResponseData
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling workflow states: %w", err)
+	}
+	if response.Team.ID == "" {
+		return nil, fmt.Errorf("team %s not found", teamID)
+	}
+	return WorkflowStates(response.Team.States.Nodes), nil
+}
+
+// ValidateStateTypes checks that every entry of types is a state type.
+func ValidateStateTypes(types []string) error {
+	for _, t := range types {
+		if !slices.Contains(StateTypes, t) {
+			return fmt.Errorf("unknown state type '%s' (expected one of %s)", t, strings.Join(StateTypes, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// LabelNode is an issue label. Team is nil for workspace-level labels.
+type LabelNode struct {
+	ID     string    `json:"id"`
+	Name   string    `json:"name"`
+	Color  string    `json:"color"`
+	Parent *NamedRef `json:"parent"`
+	Team   *NamedRef `json:"team"`
+	// IsGroup marks label groups, which hold other labels.
+	IsGroup bool `json:"isGroup,omitempty"`
+}
+
+// CycleNode is a team cycle.
+type CycleNode struct {
+	ID       string    `json:"id"`
+	Number   int       `json:"number"`
+	Name     string    `json:"name"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+}
+
+// Label returns the cycle name, falling back to its number.
+func (c CycleNode) Label() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return fmt.Sprintf("Cycle %d", c.Number)
+}
+
+// TeamDetails bundles everything needed to turn names into IDs for a team.
+type TeamDetails struct {
+	ID                       string        `json:"id"`
+	Key                      string        `json:"key"`
+	Name                     string        `json:"name"`
+	IssueEstimationType      string        `json:"issueEstimationType"`
+	IssueEstimationAllowZero bool          `json:"issueEstimationAllowZero"`
+	IssueEstimationExtended  bool          `json:"issueEstimationExtended"`
+	ActiveCycle              *CycleNode    `json:"activeCycle"`
+	Projects                 []ProjectNode `json:"-"`
+	Members                  []UserNode    `json:"-"`
+	States                   []StateNode   `json:"-"`
+	Cycles                   []CycleNode   `json:"-"`
+	Labels                   []LabelNode   `json:"-"`
+}
+
+// FetchTeams returns every team visible to the API key.
+func FetchTeams(apiKey string) ([]TeamNode, error) {
+	query := `
+	query Teams {
+		teams {
+			nodes {
+				id
+				key
+				name
+			}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching teams: %w", err)
+	}
+
+	var response TeamsResponseData
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling teams: %w", err)
+	}
+	return response.Teams.Nodes, nil
+}
+
+// FindTeam looks a team up by key, name or ID, ignoring case.
+func FindTeam(apiKey, nameOrKey string) (*TeamNode, error) {
+	teams, err := FetchTeams(apiKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range teams {
+		if team.ID == nameOrKey ||
+			strings.EqualFold(team.Key, nameOrKey) ||
+			strings.EqualFold(team.Name, nameOrKey) {
+			return &team, nil
+		}
+	}
+	return nil, fmt.Errorf("team %q not found", nameOrKey)
+}
+
+// FetchTeamDetails loads the projects, members, states, cycles and labels
+// of a team in a single request.
+func FetchTeamDetails(apiKey, teamID string) (*TeamDetails, error) {
+	query := `
+	query TeamDetails($teamId: String!, $teamFilterId: ID!) {
+		team(id: $teamId) {
+			id
+			key
+			name
+			issueEstimationType
+			issueEstimationAllowZero
+			issueEstimationExtended
+			activeCycle { id number name startsAt endsAt }
+			projects(first: 250) { nodes { id name } }
+			members(first: 250) { nodes { id name displayName email } }
+			states { nodes { id name type position } }
+			cycles(first: 50, filter: { isPast: { eq: false } }) {
+				nodes { id number name startsAt endsAt }
+			}
+		}
+		issueLabels(
+			first: 250,
+			filter: { or: [{ team: { id: { eq: $teamFilterId } } }, { team: { null: true } }] }
+		) {
+			nodes {` + LabelFields + `}
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, map[string]any{
+		"teamId":       teamID,
+		"teamFilterId": teamID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching team details: %w", err)
+	}
+
+	var response struct {
+		Team *struct {
+			TeamDetails
+			Projects ProjectConnection `json:"projects"`
+			Members  UserConnection    `json:"members"`
+			States   StateConnection   `json:"states"`
+			Cycles   struct {
+				Nodes []CycleNode `json:"nodes"`
+			} `json:"cycles"`
+		} `json:"team"`
+		IssueLabels struct {
+			Nodes []LabelNode `json:"nodes"`
+		} `json:"issueLabels"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling team details: %w", err)
+	}
+	if response.Team == nil {
+		return nil, fmt.Errorf("team %s not found", teamID)
+	}
+
+	details := response.Team.TeamDetails
+	details.Projects = response.Team.Projects.Nodes
+	details.Members = response.Team.Members.Nodes
+	details.States = response.Team.States.Nodes
+	details.Cycles = response.Team.Cycles.Nodes
+	details.Labels = response.IssueLabels.Nodes
+	return &details, nil
+}
+
+// FindProject returns the team project with the given name or ID.
+func (t *TeamDetails) FindProject(name string) (*ProjectNode, error) {
+	for _, project := range t.Projects {
+		if project.ID == name || strings.EqualFold(project.Name, name) {
+			return &project, nil
+		}
+	}
+	return nil, fmt.Errorf("project %q not found in team %s", name, t.Name)
+}
+
+// FindMember returns the team member matching an ID, email, name or
+// display name.
+func (t *TeamDetails) FindMember(name string) (*UserNode, error) {
+	for _, member := range t.Members {
+		if member.ID == name ||
+			strings.EqualFold(member.Email, name) ||
+			strings.EqualFold(member.Name, name) ||
+			strings.EqualFold(member.DisplayName, name) {
+			return &member, nil
+		}
+	}
+	return nil, fmt.Errorf("user %q is not a member of team %s", name, t.Name)
+}
+
+// MatchMembers returns the team members matching a partial name or email,
+// ignoring case. Only the best kind of match is returned: exact matches,
+// else members with a name word or email starting with the query, else
+// those containing it, else those containing its letters in order.
+func (t *TeamDetails) MatchMembers(query string) []UserNode {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+	candidates := func(member UserNode) []string {
+		return []string{strings.ToLower(member.Name), strings.ToLower(member.DisplayName), strings.ToLower(member.Email)}
+	}
+	tiers := []func(value string) bool{
+		func(value string) bool { return value == query },
+		func(value string) bool {
+			for _, word := range strings.FieldsFunc(value, func(r rune) bool { return r == ' ' || r == '.' || r == '-' }) {
+				if strings.HasPrefix(word, query) {
+					return true
+				}
+			}
+			return false
+		},
+		func(value string) bool { return strings.Contains(value, query) },
+		func(value string) bool { return isSubsequence(query, value) },
+	}
+	for _, matches := range tiers {
+		var found []UserNode
+		for _, member := range t.Members {
+			if member.ID == query {
+				return []UserNode{member}
+			}
+			for _, value := range candidates(member) {
+				if value != "" && matches(value) {
+					found = append(found, member)
+					break
+				}
+			}
+		}
+		if len(found) > 0 {
+			return found
+		}
+	}
+	return nil
+}
+
+// isSubsequence reports whether the runes of sub appear in s in order.
+func isSubsequence(sub, s string) bool {
+	rest := []rune(sub)
+	for _, r := range s {
+		if len(rest) == 0 {
+			break
+		}
+		if r == rest[0] {
+			rest = rest[1:]
+		}
+	}
+	return len(rest) == 0
+}
+
+// FindState returns the workflow state with the given name or ID.
+func (t *TeamDetails) FindState(name string) (*StateNode, error) {
+	if state, ok := WorkflowStates(t.States).Find(name); ok {
+		return state, nil
+	}
+	return nil, fmt.Errorf("state %q not found in team %s", name, t.Name)
+}
+
+// FindLabel returns the team or workspace label with the given name or ID,
+// ignoring case. Team labels take precedence over workspace labels of the
+// same name.
+func (t *TeamDetails) FindLabel(name string) (*LabelNode, error) {
+	label, err := Labels(t.Labels).Find(name, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("label %q not found for team %s", name, t.Name)
+	}
+	return label, nil
+}
+
+// FindCycle accepts "current", "next", a cycle number or a cycle name.
+func (t *TeamDetails) FindCycle(value string) (*CycleNode, error) {
+	if strings.EqualFold(value, "current") || strings.EqualFold(value, "active") {
+		if t.ActiveCycle == nil {
+			return nil, fmt.Errorf("team %s has no active cycle", t.Name)
+		}
+		return t.ActiveCycle, nil
+	}
+	if strings.EqualFold(value, "next") {
+		var next *CycleNode
+		for i, cycle := range t.Cycles {
+			if cycle.StartsAt.After(time.Now()) && (next == nil || cycle.StartsAt.Before(next.StartsAt)) {
+				next = &t.Cycles[i]
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("team %s has no upcoming cycle", t.Name)
+		}
+		return next, nil
+	}
+	number, numErr := strconv.Atoi(value)
+	for _, cycle := range t.Cycles {
+		if cycle.ID == value ||
+			(numErr == nil && cycle.Number == number) ||
+			(cycle.Name != "" && strings.EqualFold(cycle.Name, value)) {
+			return &cycle, nil
+		}
+	}
+	return nil, fmt.Errorf("cycle %q not found in team %s", value, t.Name)
+}
@@ -0,0 +1,78 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// IssueTemplate is an issue template stored in Linear.
+type IssueTemplate struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Team        *TeamNode `json:"team"`
+	Data        struct {
+		Title       string   `json:"title"`
+		Description string   `json:"description"`
+		Priority    *int     `json:"priority"`
+		Estimate    *int     `json:"estimate"`
+		StateID     string   `json:"stateId"`
+		ProjectID   string   `json:"projectId"`
+		AssigneeID  string   `json:"assigneeId"`
+		LabelIDs    []string `json:"labelIds"`
+	} `json:"-"`
+}
+
+// FetchIssueTemplates returns the workspace's issue templates.
+func FetchIssueTemplates(apiKey string) ([]IssueTemplate, error) {
+	query := `
+	query Templates {
+		templates {
+			id
+			name
+			type
+			description
+			templateData
+			team { id key name }
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching templates: %w", err)
+	}
+
+	var response struct {
+		Templates []struct {
+			IssueTemplate
+			Type         string          `json:"type"`
+			TemplateData json.RawMessage `json:"templateData"`
+		} `json:"templates"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling templates: %w", err)
+	}
+
+	var templates []IssueTemplate
+	for _, t := range response.Templates {
+		if t.Type != "issue" {
+			continue
+		}
+		tpl := t.IssueTemplate
+		raw := t.TemplateData
+		// templateData is a JSON scalar and may arrive as an encoded string.
+		var encoded string
+		if json.Unmarshal(raw, &encoded) == nil {
+			raw = json.RawMessage(encoded)
+		}
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &tpl.Data); err != nil {
+				return nil, fmt.Errorf("decoding template %s: %w", tpl.Name, err)
+			}
+		}
+		templates = append(templates, tpl)
+	}
+	return templates, nil
+}
@@ -1,18 +1,31 @@
 package linear
 
+import "time"
+
 // Define the structure of an issue node
 type IssueNode struct {
-	ID          string `json:"id"`
-	Identifier  string `json:"identifier"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	State       struct {
+	ID            string    `json:"id"`
+	Identifier    string    `json:"identifier"`
+	Title         string    `json:"title"`
+	Description   string    `json:"description"`
+	URL           string    `json:"url,omitempty"`
+	Priority      int       `json:"priority"`
+	PriorityLabel string    `json:"priorityLabel,omitempty"`
+	Estimate      *float64  `json:"estimate"`
+	DueDate       string    `json:"dueDate,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	// ArchivedAt is set for archived and trashed issues.
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	Trashed    bool       `json:"trashed,omitempty"`
+	State      struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
 		Type string `json:"type"`
 	} `json:"state"`
 	Team struct {
 		ID   string `json:"id"`
+		Key  string `json:"key"`
 		Name string `json:"name"`
 	} `json:"team"`
 	Project *struct {
@@ -23,10 +36,60 @@ type IssueNode struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
 	} `json:"assignee"`
+	Creator *NamedRef `json:"creator"`
+	Cycle   *CycleRef `json:"cycle"`
+	Parent  *IssueRef `json:"parent"`
+	Labels  struct {
+		Nodes []LabelNode `json:"nodes"`
+	} `json:"labels"`
+	// Relations and InverseRelations are only set when the query selected
+	// IssueRelationFields.
+	Relations        *RelationConnection `json:"relations,omitempty"`
+	InverseRelations *RelationConnection `json:"inverseRelations,omitempty"`
+	// Reactions is only set when the query selected IssueReactionFields.
+	Reactions []ReactionNode `json:"reactions,omitempty"`
+	// Children is only set when the query selected IssueChildrenFields.
+	Children *struct {
+		Nodes []IssueRef `json:"nodes"`
+	} `json:"children,omitempty"`
+}
+
+// IssueRef is a short reference to another issue. State is only set when
+// the query selects it.
+type IssueRef struct {
+	ID         string    `json:"id"`
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	State      *StateRef `json:"state,omitempty"`
+}
+
+// StateRef is the name and type of a workflow state.
+type StateRef struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// LabelNames returns the names of the issue's labels.
+func (i IssueNode) LabelNames() []string {
+	names := make([]string, len(i.Labels.Nodes))
+	for n, label := range i.Labels.Nodes {
+		names[n] = label.Name
+	}
+	return names
+}
+
+// LabelIDs returns the IDs of the issue's labels.
+func (i IssueNode) LabelIDs() []string {
+	ids := make([]string, len(i.Labels.Nodes))
+	for n, label := range i.Labels.Nodes {
+		ids[n] = label.ID
+	}
+	return ids
 }
 
 type IssuesConnection struct {
-	Nodes []IssueNode `json:"nodes"`
+	Nodes    []IssueNode `json:"nodes"`
+	PageInfo PageInfo    `json:"pageInfo"`
 }
 
 // Define the structure of the issues response for listing
@@ -37,31 +100,15 @@ type IssuesResponseData struct {
 // Define the structure of the issue creation response
 type IssueCreateResponseData struct {
 	IssueCreate struct {
-		Success bool `json:"success"`
-		Issue   struct {
-			ID          string    `json:"id"`
-			Title       string    `json:"title"`
-			Description string    `json:"description"`
-			Team        string    `json:"name"` // This seems incorrect, should probably be a Team struct
-			Project     *struct { // Needs to be nullable like in IssueNode
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"project"`
-			Assignee *struct { // Needs to be nullable like in IssueNode
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"assignee"`
-			State struct {
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"state"`
-		} `json:"issue"`
+		Success bool      `json:"success"`
+		Issue   IssueNode `json:"issue"`
 	} `json:"issueCreate"`
 }
 
 // Define the structure for Teams
 type TeamNode struct {
 	ID   string `json:"id"`
+	Key  string `json:"key"`
 	Name string `json:"name"`
 }
 
@@ -73,10 +120,21 @@ type TeamsResponseData struct {
 	Teams TeamsConnection `json:"teams"`
 }
 
-// Define the structure for Projects
+// Define the structure for Projects. Only ID and Name are selected by team
+// details; the other fields are set when the query selected ProjectFields.
 type ProjectNode struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	URL         string             `json:"url,omitempty"`
+	Status      *ProjectStatusNode `json:"status,omitempty"`
+	// Progress is the share of completed issue estimates, from 0 to 1.
+	Progress   float64          `json:"progress"`
+	StartDate  string           `json:"startDate,omitempty"`
+	TargetDate string           `json:"targetDate,omitempty"`
+	Lead       *UserNode        `json:"lead,omitempty"`
+	Teams      *TeamsConnection `json:"teams,omitempty"`
+	ArchivedAt *time.Time       `json:"archivedAt,omitempty"`
 }
 
 type ProjectConnection struct {
@@ -93,8 +151,10 @@ type TeamProjectsResponseData struct {
 
 // Define the structure for Users/Members
 type UserNode struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Email       string `json:"email,omitempty"`
 }
 
 type UserConnection struct {
@@ -112,9 +172,10 @@ type TeamMembersResponseData struct {
 
 // Define the structure for States
 type StateNode struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Type string `json:"type"`
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Position float64 `json:"position,omitempty"`
 }
 
 type StateConnection struct {
@@ -134,3 +195,50 @@ type PromptContent struct {
 	ErrorMsg string
 	Label    string
 }
+
+// PageInfo is the cursor information returned with paginated connections.
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// ProjectID returns the ID of the issue's project, or "" if it has none.
+func (i IssueNode) ProjectID() string {
+	if i.Project == nil {
+		return ""
+	}
+	return i.Project.ID
+}
+
+// AssigneeID returns the ID of the assignee, or "" if unassigned.
+func (i IssueNode) AssigneeID() string {
+	if i.Assignee == nil {
+		return ""
+	}
+	return i.Assignee.ID
+}
+
+// CycleID returns the ID of the issue's cycle, or "" if it has none.
+func (i IssueNode) CycleID() string {
+	if i.Cycle == nil {
+		return ""
+	}
+	return i.Cycle.ID
+}
+
+// ParentID returns the ID of the parent issue, or "" if it has none.
+func (i IssueNode) ParentID() string {
+	if i.Parent == nil {
+		return ""
+	}
+	return i.Parent.ID
+}
+
+// EstimateValue returns the estimate as a whole number, or nil if unset.
+func (i IssueNode) EstimateValue() *int {
+	if i.Estimate == nil {
+		return nil
+	}
+	estimate := int(*i.Estimate)
+	return &estimate
+}
@@ -0,0 +1,34 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Matthew-K310/linear-cli/internal/api"
+)
+
+// FetchViewer returns the user the API key belongs to.
+func FetchViewer(apiKey string) (*UserNode, error) {
+	query := `
+	query Viewer {
+		viewer {
+			id
+			name
+			displayName
+			email
+		}
+	}
+	`
+	data, err := api.MakeGraphQLRequest(apiKey, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching current user: %w", err)
+	}
+
+	var response struct {
+		Viewer UserNode `json:"viewer"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshalling current user: %w", err)
+	}
+	return &response.Viewer, nil
+}
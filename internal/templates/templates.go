@@ -0,0 +1,208 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Matthew-K310/linear-cli/internal/config"
+	"github.com/Matthew-K310/linear-cli/internal/editor"
+)
+
+// Template sources, in increasing order of precedence.
+const (
+	SourceUser = "user"
+	SourceRepo = "repo"
+)
+
+// RepoDir is the templates directory relative to the repository root.
+const RepoDir = ".linear/templates"
+
+// Prompt is a custom value a template asks for when it is used. Its answer
+// is available in the template as {{.<Name>}}.
+type Prompt struct {
+	Name     string `yaml:"name"`
+	Label    string `yaml:"label,omitempty"`
+	Default  string `yaml:"default,omitempty"`
+	Required bool   `yaml:"required,omitempty"`
+}
+
+// Template is an issue template file: markdown with YAML frontmatter.
+type Template struct {
+	Name    string   `yaml:"-"`
+	Path    string   `yaml:"-"`
+	Source  string   `yaml:"-"`
+	About   string   `yaml:"about"`
+	Prompts []Prompt `yaml:"prompts"`
+	Content []byte   `yaml:"-"`
+}
+
+// UserDir returns the user-level templates directory.
+func UserDir() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "templates"), nil
+}
+
+// RepoRoot returns the root of the git repository containing the working
+// directory, or "" outside a repository.
+func RepoRoot() string {
+	out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// List returns every available template sorted by name. A repo template
+// hides a user template of the same name.
+func List() ([]Template, error) {
+	byName := map[string]Template{}
+
+	if dir, err := UserDir(); err == nil {
+		if err := loadDir(dir, SourceUser, byName); err != nil {
+			return nil, err
+		}
+	}
+	if root := RepoRoot(); root != "" {
+		if err := loadDir(filepath.Join(root, RepoDir), SourceRepo, byName); err != nil {
+			return nil, err
+		}
+	}
+
+	list := make([]Template, 0, len(byName))
+	for _, tpl := range byName {
+		list = append(list, tpl)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// Find returns the template with the given name.
+func Find(name string) (*Template, error) {
+	list, err := List()
+	if err != nil {
+		return nil, err
+	}
+	for _, tpl := range list {
+		if strings.EqualFold(tpl.Name, name) {
+			return &tpl, nil
+		}
+	}
+	return nil, fmt.Errorf("template %q not found", name)
+}
+
+func loadDir(dir, source string, into map[string]Template) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading templates in %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		tpl, err := Load(path)
+		if err != nil {
+			return err
+		}
+		tpl.Source = source
+		into[tpl.Name] = *tpl
+	}
+	return nil
+}
+
+// Load reads a single template file. Its name is the file name without the
+// .md extension.
+func Load(path string) (*Template, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading template %s: %w", path, err)
+	}
+
+	tpl := &Template{
+		Name:    strings.TrimSuffix(filepath.Base(path), ".md"),
+		Path:    path,
+		Content: content,
+	}
+	if _, err := editor.ParseFrontmatter(content, tpl); err != nil {
+		return nil, fmt.Errorf("template %s: %w", path, err)
+	}
+	return tpl, nil
+}
+
+// Context returns the built-in placeholder values: Branch, Date and User.
+func Context() map[string]any {
+	data := map[string]any{
+		"Date": time.Now().Format("2006-01-02"),
+	}
+
+	if out, err := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD").Output(); err == nil {
+		data["Branch"] = strings.TrimSpace(string(out))
+	} else {
+		data["Branch"] = ""
+	}
+
+	data["User"] = ""
+	if out, err := exec.Command("git", "config", "user.name").Output(); err == nil &&
+		strings.TrimSpace(string(out)) != "" {
+		data["User"] = strings.TrimSpace(string(out))
+	} else if current, err := user.Current(); err == nil {
+		data["User"] = current.Username
+	}
+
+	return data
+}
+
+// Render executes text as a Go template against data. Missing keys are an
+// error so that typos in placeholders are caught early.
+func Render(text string, data map[string]any) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tpl, err := template.New("issue").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Skeleton is the content of a newly created template.
+const Skeleton = `---
+about: Describe when to use this template
+# Values may use placeholders: {{.Branch}}, {{.Date}}, {{.User}} and the
+# names of the prompts below. Quote values that contain placeholders.
+title: "{{.Summary}}"
+team: ""
+project: ""
+assignee: ""
+state: ""
+labels: []
+priority: ""
+prompts:
+  - name: Summary
+    label: One-line summary
+    required: true
+---
+
+## Context
+
+Reported by {{.User}} on {{.Date}} (branch: {{.Branch}}).
+`